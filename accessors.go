@@ -2,9 +2,7 @@ package objx
 
 import (
 	"reflect"
-	"regexp"
-	"strconv"
-	"strings"
+	"sort"
 )
 
 const (
@@ -13,185 +11,714 @@ const (
 	//
 	// For example, `location.address.city`
 	PathSeparator string = "."
-
-	// arrayAccessRegexString is the regex used to extract the array number
-	// from the access path
-	arrayAccessRegexString = `^(.+)\[([0-9]+)\]$`
-
-	// mapAccessRegexString is the regex used to extract the map key
-	// from the access path
-	mapAccessRegexString = `^([^\[]*)\[([^\]]+)\](.*)$`
 )
 
-// arrayAccessRegex is the compiled arrayAccessRegexString
-var arrayAccessRegex = regexp.MustCompile(arrayAccessRegexString)
-
-// mapAccessRegex is the compiled mapAccessRegexString
-var mapAccessRegex = regexp.MustCompile(mapAccessRegexString)
-
 // Get gets the value using the specified selector and
 // returns it inside a new Obj object.
 //
 // If it cannot find the value, Get will return a nil
 // value inside an instance of Obj.
 //
-// Get can only operate directly on map[string]interface{} and []interface.
+// Get operates on maps with keys of any string-convertible type, structs
+// (by field name or json tag, like encoding/json) and slices, through any
+// number of pointers.
 //
 // # Example
 //
 // To access the title of the third chapter of the second book, do:
 //
 //	o.Get("books[1].chapters[2].title")
+//
+// Keys containing dots or brackets can be quoted or escaped with a backslash:
+//
+//	o.Get(`labels["app.kubernetes.io/name"]`)
+//	o.Get(`metrics.latency\[p99\]`)
+//
+// Negative indexes count back from the end of an array and ranges select
+// a part of it, following the semantics of Python slices:
+//
+//	o.Get("books[-1].title")
+//	o.Get("books[1:3]")
+//	o.Get("books[::-1]")
+//
+// A `*` matches every element of an array or every value of a map. Get
+// then returns all matches as a []interface{}:
+//
+//	o.Get("books[*].title")
+//	o.Get("users.*.email")
+//
+// A filter selects the elements of an array or the values of a map for
+// which a predicate holds. `@` refers to the element being tested and
+// predicates support comparisons, `&&`, `||`, `!`, parentheses and string,
+// number, boolean and null literals. A path on its own holds when it
+// exists and is neither null nor false:
+//
+//	o.Get("books[?(@.price < 10 && @.inStock)].title")
+//
+// A double dot searches the value and all of its descendants, returning
+// every match in document order (map keys are visited in sorted order):
+//
+//	o.Get("..id")
+//	o.Get("resources..arn")
 func (m Map) Get(selector string) *Value {
-	rawObj := access(m, selector, nil, false)
-	return &Value{data: rawObj}
+	s, err := compileCached(selector, defaultOptions)
+	if err != nil {
+		return &Value{missing: true}
+	}
+	return s.Get(m)
 }
 
 // Set sets the value using the specified selector and
 // returns the object on which Set was called.
 //
-// Set can only operate directly on map[string]interface{} and []interface
+// Set operates on the same maps, structs and slices as Get, as long as the
+// value can be assigned to them. Struct fields can only be set through a
+// pointer.
 //
 // # Example
 //
 // To set the title of the third chapter of the second book, do:
 //
 //	o.Set("books[1].chapters[2].title","Time to Go")
+//
+// Missing maps and arrays along the selector are created, depending on
+// whether the next part is a key or an index. Setting the element right
+// after the end of an array appends it:
+//
+//	o.Set("tags[0]", "go")   // tags is now []interface{}{"go"}
+//	o.Set("tags[1]", "objx") // tags is now []interface{}{"go", "objx"}
+//
+// If the selector contains a `*`, a filter or `..`, every match is set. Recursive
+// descent only replaces existing values. A trailing range sets every element
+// it selects:
+//
+//	o.Set("tags[1:]", "none") // sets every tag but the first one
 func (m Map) Set(selector string, value interface{}) Map {
-	access(m, selector, value, true)
-	return m
-}
-
-// getIndex returns the index, which is hold in s by two branches.
-// It also returns s without the index part, e.g. name[1] will return (1, name).
-// If no index is found, -1 is returned
-func getIndex(s string) (int, string) {
-	arrayMatches := arrayAccessRegex.FindStringSubmatch(s)
-	if len(arrayMatches) > 0 {
-		// Get the key into the map
-		selector := arrayMatches[1]
-		// Get the index into the array at the key
-		// We know this can't fail because arrayMatches[2] is an int for sure
-		index, _ := strconv.Atoi(arrayMatches[2])
-		return index, selector
-	}
-	return -1, s
-}
-
-// getKey returns the key which is held in s by two brackets.
-// It also returns the next selector.
-func getKey(s string) (string, string) {
-	selSegs := strings.SplitN(s, PathSeparator, 2)
-	thisSel := selSegs[0]
-	nextSel := ""
-
-	if len(selSegs) > 1 {
-		nextSel = selSegs[1]
-	}
-
-	mapMatches := mapAccessRegex.FindStringSubmatch(s)
-	if len(mapMatches) > 0 {
-		if _, err := strconv.Atoi(mapMatches[2]); err != nil {
-			thisSel = mapMatches[1]
-			nextSel = "[" + mapMatches[2] + "]" + mapMatches[3]
-
-			if thisSel == "" {
-				thisSel = mapMatches[2]
-				nextSel = mapMatches[3]
+	return m.SetWith(selector, value)
+}
+
+// SetWith sets the value using the specified selector and options and
+// returns the object on which SetWith was called.
+//
+// See Set for how the selector is handled.
+func (m Map) SetWith(selector string, value interface{}, options ...SetOption) Map {
+	s, err := compileCached(selector, defaultOptions)
+	if err != nil {
+		return m
+	}
+	return s.SetWith(m, value, options...)
+}
+
+// Delete removes the value using the specified selector and returns it
+// inside a new Value object, along with whether anything was removed.
+//
+// Removing an element of an array shifts the elements after it and stores
+// the shortened array in its parent.
+//
+// # Example
+//
+// To remove the third chapter of the second book, do:
+//
+//	o.Delete("books[1].chapters[2]")
+//
+// If the selector contains a `*`, a filter or `..`, every match is removed
+// and the Value holds a []interface{} of the removed values:
+//
+//	o.Delete("books[?(@.price > 10)]")
+func (m Map) Delete(selector string) (*Value, bool) {
+	s, err := compileCached(selector, defaultOptions)
+	if err != nil {
+		return &Value{missing: true}, false
+	}
+	return s.Delete(m)
+}
+
+// maxPadding is the largest number of elements PadSlices adds to an
+// array to reach an index, so that a large index in a selector cannot
+// exhaust the memory.
+const maxPadding = 1 << 16
+
+// SetOption configures how values are set by SetWith.
+type SetOption func(*accessor)
+
+// PadSlices lets SetWith grow arrays past their end, filling the gap
+// with zero values (nil for []interface{}). An array is never padded
+// with more than 65536 elements, indexes further away are ignored.
+//
+// # Example
+//
+//	o.SetWith("tags[2]", "objx", objx.PadSlices()) // tags is now []interface{}{nil, nil, "objx"}
+func PadSlices() SetOption {
+	return func(a *accessor) {
+		a.pad = true
+	}
+}
+
+// accessMode is the action performed by an accessor.
+type accessMode int
+
+const (
+	// accessGet collects the matched values
+	accessGet accessMode = iota
+	// accessSet replaces the matched values
+	accessSet
+	// accessDelete removes the matched values
+	accessDelete
+)
+
+// accessor holds the state of a single access of a selector.
+type accessor struct {
+	// mode is the action performed on the matched values
+	mode accessMode
+	// value is the value being set
+	value interface{}
+	// multi is whether the selector may match more than one value
+	multi bool
+	// existingOnly is whether a set may only replace existing values
+	existingOnly bool
+	// pad is whether a set may grow slices past their end
+	pad bool
+	// normalize is the key normalizer, if any
+	normalize func(key string) string
+	// result is the value matched by a single-value selector
+	result interface{}
+	// found is whether any value was matched, or stored by a set
+	found bool
+	// matches holds the values matched by a multi-value selector
+	matches []interface{}
+}
+
+// match records a value matched by the selector.
+func (a *accessor) match(v interface{}) {
+	a.found = true
+	if a.multi {
+		a.matches = append(a.matches, v)
+		return
+	}
+	a.result = v
+}
+
+// access accesses the object using the selector segments and performs
+// the appropriate action.
+//
+// If the length of current had to change (e.g. an element of a slice was
+// deleted), access returns its replacement and true so that the caller
+// can store it in the parent.
+func (a *accessor) access(current interface{}, segments []segment) (interface{}, bool) {
+	if curMap, ok := current.(Map); ok {
+		current = map[string]interface{}(curMap)
+	}
+	seg := segments[0]
+
+	switch seg.kind {
+	case segmentKey:
+		if isKeyed(current) {
+			a.accessKey(current, seg.key, segments)
+		}
+	case segmentPointer:
+		if isKeyed(current) {
+			a.accessKey(current, seg.key, segments)
+			break
+		}
+		index, ok := pointerIndex(seg.key)
+		if seg.key == "-" && a.mode == accessSet {
+			index, ok = sliceLen(current), true
+		}
+		if ok {
+			return a.accessElements(current, []int{index}, segments)
+		}
+	case segmentIndex:
+		index := seg.index
+		if index < 0 {
+			index += sliceLen(current)
+		}
+		return a.accessElements(current, []int{index}, segments)
+	case segmentRange:
+		if len(segments) == 1 && a.mode != accessGet {
+			indexes := rangeIndexes(sliceLen(current), seg)
+			if a.mode == accessSet {
+				return a.accessElements(current, indexes, segments)
+			}
+			if len(indexes) > 0 {
+				removed, _ := getRange(current, seg)
+				a.match(removed)
+				return removeIndexes(current, indexes), true
+			}
+			break
+		}
+		if child, ok := getRange(current, seg); ok {
+			a.next(child, segments)
+		}
+	case segmentWildcard, segmentFilter:
+		if keys, ok := keysOf(current); ok {
+			for _, key := range keys {
+				child, _ := getKey(current, key)
+				if seg.kind == segmentWildcard || truthy(seg.filter.eval(child)) {
+					a.accessKey(current, key, segments)
+				}
+			}
+			break
+		}
+		var indexes []int
+		for i, n := 0, sliceLen(current); i < n; i++ {
+			child, _ := getIndex(current, i)
+			if seg.kind == segmentWildcard || truthy(seg.filter.eval(child)) {
+				indexes = append(indexes, i)
 			}
+		}
+		return a.accessElements(current, indexes, segments)
+	case segmentDescent:
+		existingOnly := a.existingOnly
+		a.existingOnly = true
+		replacement, replaced := a.descend(current, segments[1:])
+		a.existingOnly = existingOnly
+		return replacement, replaced
+	}
+	return nil, false
+}
+
+// accessKey accesses the value at key of the map or struct held in
+// current.
+func (a *accessor) accessKey(current interface{}, key string, segments []segment) {
+	child, exists := getKey(current, key)
+	if !exists && a.normalize != nil {
+		if k, ok := a.resolveKey(current, key); ok {
+			key = k
+			child, exists = getKey(current, key)
+		}
+	}
+	if a.mode == accessSet && a.existingOnly && !exists {
+		return
+	}
 
-			if nextSel == "" {
-				selSegs = []string{"", ""}
-			} else if nextSel[0] == '.' {
-				nextSel = nextSel[1:]
+	if len(segments) == 1 {
+		switch {
+		case a.mode == accessSet:
+			if setKey(current, key, a.value) {
+				a.found = true
 			}
+		case exists && a.mode == accessDelete:
+			if deleteKey(current, key) {
+				a.match(child)
+			}
+		case exists:
+			a.match(child)
+		}
+		return
+	}
+
+	original, existed := child, exists
+	created := false
+	if container, ok := newContainer(child, segments[1]); ok && a.mode == accessSet && !a.existingOnly {
+		if !setKey(current, key, container) {
+			return
 		}
+		child, exists, created = container, true, true
+	}
+	if !exists {
+		return
 	}
 
-	return thisSel, nextSel
+	replacement, replaced, stored := a.accessChild(child, segments[1:])
+	switch {
+	case a.mode == accessSet && !stored:
+		// leave the object untouched when nothing could be set
+		if created && existed {
+			setKey(current, key, original)
+		} else if created {
+			deleteKey(current, key)
+		}
+	case replaced:
+		setKey(current, key, replacement)
+	}
 }
 
-// access accesses the object using the selector and performs the
-// appropriate action.
-func access(current interface{}, selector string, value interface{}, isSet bool) interface{} {
-	thisSel, nextSel := getKey(selector)
+// accessChild accesses child with the remaining segments like access
+// does, and also returns whether a set stored anything in it.
+func (a *accessor) accessChild(child interface{}, segments []segment) (interface{}, bool, bool) {
+	found := a.found
+	a.found = false
+	replacement, replaced := a.access(child, segments)
+	stored := a.found
+	a.found = found || stored
+	return replacement, replaced, stored
+}
 
-	indexes := []int{}
-	for strings.Contains(thisSel, "[") {
-		prevSel := thisSel
-		index := -1
-		index, thisSel = getIndex(thisSel)
-		indexes = append(indexes, index)
-		if prevSel == thisSel {
-			break
+// resolveKey returns the first key of the map or struct held in current
+// that normalizes to the same string as key.
+func (a *accessor) resolveKey(current interface{}, key string) (string, bool) {
+	keys, _ := keysOf(current)
+	normalized := a.normalize(key)
+	for _, k := range keys {
+		if a.normalize(k) == normalized {
+			return k, true
 		}
 	}
+	return "", false
+}
 
-	if curMap, ok := current.(Map); ok {
-		current = map[string]interface{}(curMap)
+// accessElements accesses the elements at indexes of the slice held in
+// current. Indexes out of range are ignored, unless a set may grow the
+// slice.
+func (a *accessor) accessElements(current interface{}, indexes []int, segments []segment) (interface{}, bool) {
+	if !isSlice(current) {
+		return nil, false
 	}
-	// get the object in question
-	switch current.(type) {
-	case map[string]interface{}:
-		curMSI := current.(map[string]interface{})
-		if nextSel == "" && isSet {
-			curMSI[thisSel] = value
-			return nil
-		}
 
-		_, ok := curMSI[thisSel].(map[string]interface{})
+	last := len(segments) == 1
+	create := a.mode == accessSet && !a.existingOnly
+	replaced, stored := false, false
+	var removed []int
+	for _, index := range indexes {
+		if index < 0 {
+			continue
+		}
+		if create && index >= sliceLen(current) {
+			grown, ok := a.grow(current, index, last)
+			if !ok {
+				continue
+			}
+			current, replaced = grown, true
+		}
+		child, ok := getIndex(current, index)
 		if !ok {
-			_, ok = curMSI[thisSel].(Map)
+			continue
 		}
 
-		if (curMSI[thisSel] == nil || !ok) && len(indexes) == 0 && isSet {
-			curMSI[thisSel] = map[string]interface{}{}
+		switch {
+		case !last:
+			original, created := child, false
+			if container, ok := newContainer(child, segments[1]); ok && create {
+				if !setIndex(current, index, container) {
+					continue
+				}
+				child, created = container, true
+			}
+			replacement, ok, childStored := a.accessChild(child, segments[1:])
+			switch {
+			case a.mode == accessSet && !childStored:
+				if created {
+					setIndex(current, index, original)
+				}
+			case ok:
+				setIndex(current, index, replacement)
+			}
+			stored = stored || childStored
+		case a.mode == accessSet:
+			if setIndex(current, index, a.value) {
+				a.found, stored = true, true
+			}
+		case a.mode == accessDelete:
+			a.match(child)
+			removed = append(removed, index)
+		default:
+			a.match(child)
 		}
+	}
 
-		current = curMSI[thisSel]
-	default:
-		current = nil
+	if len(removed) > 0 {
+		return removeIndexes(current, removed), true
 	}
+	if a.mode == accessSet && !stored {
+		// a slice grown for nothing is dropped
+		return nil, false
+	}
+	return current, replaced
+}
 
-	// do we need to access the item of an array?
-	if len(indexes) > 0 {
-		num := len(indexes)
-		for num > 0 {
-			num--
-			index := indexes[num]
-			indexes = indexes[:num]
-			if array, ok := interSlice(current); ok {
-				if index < len(array) {
-					current = array[index]
-				} else {
-					current = nil
-					break
-				}
+// grow returns the slice held in v grown to hold index, or false if it
+// cannot grow that far.
+//
+// Without padding, a slice only grows by appending to its end, and with
+// it by no more than maxPadding elements. Typed slices only grow to hold
+// the value being set.
+func (a *accessor) grow(v interface{}, index int, last bool) (interface{}, bool) {
+	n := sliceLen(v)
+	if index > n && !a.pad || index-n >= maxPadding {
+		return nil, false
+	}
+	if array, ok := v.([]interface{}); ok {
+		return append(array, make([]interface{}, index+1-n)...), true
+	}
+
+	s := reflect.ValueOf(v)
+	if !last || (a.value != nil && !reflect.TypeOf(a.value).AssignableTo(s.Type().Elem())) {
+		return nil, false
+	}
+	return reflect.AppendSlice(s, reflect.MakeSlice(s.Type(), index+1-n, index+1-n)).Interface(), true
+}
+
+// descend accesses current and each of its descendants, in document
+// order, with the remaining segments.
+func (a *accessor) descend(current interface{}, segments []segment) (interface{}, bool) {
+	replacement, replaced := a.access(current, segments)
+	if replaced {
+		current = replacement
+	}
+
+	if keys, ok := keysOf(current); ok {
+		for _, key := range keys {
+			child, _ := getKey(current, key)
+			if replacement, ok := a.descend(child, segments); ok {
+				setKey(current, key, replacement)
+			}
+		}
+	} else {
+		for i, n := 0, sliceLen(current); i < n; i++ {
+			child, _ := getIndex(current, i)
+			if replacement, ok := a.descend(child, segments); ok {
+				setIndex(current, i, replacement)
 			}
 		}
 	}
+	return current, replaced
+}
 
-	if nextSel != "" {
-		current = access(current, nextSel, value, isSet)
+// next continues the access with the child matched by the first segment.
+func (a *accessor) next(child interface{}, segments []segment) {
+	if len(segments) > 1 {
+		a.access(child, segments[1:])
+	} else if a.mode == accessGet {
+		a.match(child)
 	}
-	return current
 }
 
-func interSlice(slice interface{}) ([]interface{}, bool) {
-	if array, ok := slice.([]interface{}); ok {
-		return array, ok
+// newContainer returns the container a set has to replace v with before
+// accessing it with the next segment, if v is not a suitable one.
+func newContainer(v interface{}, next segment) (interface{}, bool) {
+	switch next.kind {
+	case segmentKey:
+		if !isKeyed(v) {
+			return map[string]interface{}{}, true
+		}
+	case segmentIndex:
+		if !isSlice(v) {
+			return []interface{}{}, true
+		}
+	case segmentPointer:
+		switch {
+		case isKeyed(v) || isSlice(v):
+		case next.key == "-":
+			return []interface{}{}, true
+		default:
+			return map[string]interface{}{}, true
+		}
+	}
+	return nil, false
+}
+
+// toMSI returns the map[string]interface{} held in v, if any.
+func toMSI(v interface{}) (map[string]interface{}, bool) {
+	switch m := v.(type) {
+	case map[string]interface{}:
+		return m, true
+	case Map:
+		return m, true
+	}
+	return nil, false
+}
+
+// isSlice returns whether v holds a slice.
+func isSlice(v interface{}) bool {
+	if _, ok := v.([]interface{}); ok {
+		return true
+	}
+	return reflect.ValueOf(v).Kind() == reflect.Slice
+}
+
+// sortedKeys returns the keys of m in ascending order.
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
 	}
+	sort.Strings(keys)
+	return keys
+}
 
-	s := reflect.ValueOf(slice)
+// sliceLen returns the length of the slice held in v, or 0 if v is not
+// a slice.
+func sliceLen(v interface{}) int {
+	if array, ok := v.([]interface{}); ok {
+		return len(array)
+	}
+	s := reflect.ValueOf(v)
 	if s.Kind() != reflect.Slice {
+		return 0
+	}
+	return s.Len()
+}
+
+// isMSI returns whether v is a map[string]interface{} or a Map.
+func isMSI(v interface{}) bool {
+	switch v.(type) {
+	case map[string]interface{}, Map:
+		return true
+	}
+	return false
+}
+
+// getIndex returns the element at index of the slice held in v. Negative
+// indexes count back from the end of the slice.
+func getIndex(v interface{}, index int) (interface{}, bool) {
+	if array, ok := v.([]interface{}); ok {
+		if index < 0 {
+			index += len(array)
+		}
+		if index >= 0 && index < len(array) {
+			return array[index], true
+		}
 		return nil, false
 	}
 
-	ret := make([]interface{}, s.Len())
+	s := reflect.ValueOf(v)
+	if s.Kind() != reflect.Slice {
+		return nil, false
+	}
+	if index < 0 {
+		index += s.Len()
+	}
+	if index < 0 || index >= s.Len() {
+		return nil, false
+	}
+	return s.Index(index).Interface(), true
+}
 
-	for i := 0; i < s.Len(); i++ {
-		ret[i] = s.Index(i).Interface()
+// setIndex sets the element at index of the slice held in v. Negative
+// indexes count back from the end of the slice. It does nothing if the
+// index is out of range or the value does not fit the slice.
+func setIndex(v interface{}, index int, value interface{}) bool {
+	if array, ok := v.([]interface{}); ok {
+		if index < 0 {
+			index += len(array)
+		}
+		if index >= 0 && index < len(array) {
+			array[index] = value
+			return true
+		}
+		return false
+	}
+
+	s := reflect.ValueOf(v)
+	if s.Kind() != reflect.Slice {
+		return false
+	}
+	if index < 0 {
+		index += s.Len()
+	}
+	if index < 0 || index >= s.Len() {
+		return false
+	}
+	elemType := s.Type().Elem()
+	if value == nil {
+		s.Index(index).Set(reflect.Zero(elemType))
+		return true
+	}
+	val := reflect.ValueOf(value)
+	if !val.Type().AssignableTo(elemType) {
+		return false
+	}
+	s.Index(index).Set(val)
+	return true
+}
+
+// getRange returns the elements of the slice held in v selected by
+// the range segment, following the semantics of Python slices. The
+// result has the same type as v.
+func getRange(v interface{}, seg segment) (interface{}, bool) {
+	s := reflect.ValueOf(v)
+	if s.Kind() != reflect.Slice {
+		return nil, false
+	}
+
+	start, end := rangeBounds(s.Len(), seg)
+	if seg.step == 1 {
+		if end < start {
+			end = start
+		}
+		return s.Slice(start, end).Interface(), true
+	}
+
+	result := reflect.MakeSlice(s.Type(), 0, 0)
+	for _, i := range rangeIndexes(s.Len(), seg) {
+		result = reflect.Append(result, s.Index(i))
+	}
+	return result.Interface(), true
+}
+
+// rangeBounds resolves the start and end of the range segment for a
+// slice of length n.
+func rangeBounds(n int, seg segment) (int, int) {
+	lower, upper := 0, n
+	start, end := lower, upper
+	if seg.step < 0 {
+		lower, upper = -1, n-1
+		start, end = upper, lower
+	}
+	clamp := func(i int) int {
+		if i < 0 {
+			i += n
+		}
+		if i < lower {
+			return lower
+		}
+		if i > upper {
+			return upper
+		}
+		return i
+	}
+	if seg.start != nil {
+		start = clamp(*seg.start)
+	}
+	if seg.end != nil {
+		end = clamp(*seg.end)
+	}
+	return start, end
+}
+
+// rangeIndexes returns the indexes selected by the range segment for a
+// slice of length n.
+func rangeIndexes(n int, seg segment) []int {
+	var indexes []int
+	start, end := rangeBounds(n, seg)
+	// a step longer than the slice selects a single element, clamping it
+	// keeps i from overflowing
+	step := seg.step
+	if step > n {
+		step = n
+	} else if step < -n {
+		step = -n
+	}
+	for i := start; (step > 0 && i < end) || (step < 0 && i > end); i += step {
+		indexes = append(indexes, i)
+	}
+	return indexes
+}
+
+// removeIndexes returns a copy of the slice held in v without the
+// elements at indexes.
+func removeIndexes(v interface{}, indexes []int) interface{} {
+	removed := make(map[int]bool, len(indexes))
+	for _, index := range indexes {
+		removed[index] = true
+	}
+
+	if array, ok := v.([]interface{}); ok {
+		result := make([]interface{}, 0, len(array)-len(removed))
+		for i, elem := range array {
+			if !removed[i] {
+				result = append(result, elem)
+			}
+		}
+		return result
 	}
 
-	return ret, true
+	s := reflect.ValueOf(v)
+	result := reflect.MakeSlice(s.Type(), 0, s.Len()-len(removed))
+	for i := 0; i < s.Len(); i++ {
+		if !removed[i] {
+			result = reflect.Append(result, s.Index(i))
+		}
+	}
+	return result.Interface()
 }
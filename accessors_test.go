@@ -256,3 +256,405 @@ func TestAccessorsNested(t *testing.T) {
 	value = d.Get("values[1][2].names[0]").String()
 	assert.Equal(t, "Captain", value)
 }
+
+func TestAccessorsQuotedAndEscapedKeys(t *testing.T) {
+	m := objx.Map{
+		"labels": objx.Map{
+			"app.kubernetes.io/name": "objx",
+			"it's":                   "quoted",
+			"0":                      "zero",
+		},
+		"metrics[p99]": 42,
+		`back\slash`:   true,
+	}
+
+	assert.Equal(t, "objx", m.Get(`labels["app.kubernetes.io/name"]`).Data())
+	assert.Equal(t, "objx", m.Get(`labels['app.kubernetes.io/name']`).Data())
+	assert.Equal(t, "objx", m.Get(`labels.app\.kubernetes\.io/name`).Data())
+	assert.Equal(t, "quoted", m.Get(`labels["it's"]`).Data())
+	assert.Equal(t, "quoted", m.Get(`labels['it\'s']`).Data())
+	assert.Equal(t, "zero", m.Get(`labels["0"]`).Data())
+	assert.Equal(t, 42, m.Get(`metrics\[p99\]`).Data())
+	assert.Equal(t, 42, m.Get(`["metrics[p99]"]`).Data())
+	assert.Equal(t, true, m.Get(`back\\slash`).Data())
+
+	assert.True(t, m.Has(`labels["app.kubernetes.io/name"]`))
+	assert.False(t, m.Has(`labels["app"]`))
+	assert.False(t, m.Has(`labels.app.kubernetes.io/name`))
+
+	m.Set(`labels["app.kubernetes.io/name"]`, "testify")
+	m.Set(`metrics\[p99\]`, 43)
+	m.Set(`new["a.b"].c`, 1)
+
+	assert.Equal(t, "testify", m.Get(`labels.app\.kubernetes\.io/name`).Data())
+	assert.Equal(t, 43, m["metrics[p99]"])
+	assert.Equal(t, 1, m.Get(`new[a.b].c`).Data())
+	assert.Nil(t, m.Get("new.a.b.c").Data())
+}
+
+func TestAccessorsWildcard(t *testing.T) {
+	m := objx.MustFromJSON(`{
+		"books": [{"title": "Go"}, {"title": "objx"}, {"pages": 10}],
+		"users": {"tyler": {"email": "t@example.com"}, "mat": {"email": "m@example.com"}},
+		"*": "star"
+	}`)
+
+	assert.Equal(t, []interface{}{"Go", "objx"}, m.Get("books[*].title").Data())
+	assert.Equal(t, []interface{}{"m@example.com", "t@example.com"}, m.Get("users.*.email").Data())
+	assert.Equal(t, []interface{}{"m@example.com", "t@example.com"}, m.Get("users[*][email]").Data())
+	assert.Equal(t, 3, len(m.Get("books.*").InterSlice()))
+	assert.Equal(t, []interface{}{}, m.Get("books[*].missing").Data())
+	assert.Equal(t, []interface{}{}, m.Get("missing[*]").Data())
+	assert.Equal(t, "star", m.Get(`\*`).Data())
+	assert.Equal(t, "star", m.Get(`["*"]`).Data())
+
+	titles := m.Get("books[*].title").CollectInter(func(i int, v interface{}) interface{} {
+		return v.(string) + "!"
+	})
+	assert.Equal(t, []interface{}{"Go!", "objx!"}, titles.Data())
+
+	assert.True(t, m.Has("books[*].title"))
+	assert.True(t, m.Has("books[*].pages"))
+	assert.False(t, m.Has("books[*].missing"))
+}
+
+func TestAccessorsWildcardOnTypedSlice(t *testing.T) {
+	m := objx.Map{
+		"books": []objx.Map{{"title": "Go"}, {"title": "objx"}},
+		"tags":  []string{"one", "two"},
+	}
+
+	assert.Equal(t, []interface{}{"Go", "objx"}, m.Get("books[*].title").Data())
+	assert.Equal(t, []interface{}{"one", "two"}, m.Get("tags[*]").Data())
+}
+
+func TestAccessorsSetWildcard(t *testing.T) {
+	m := objx.MustFromJSON(`{
+		"books": [{"title": "Go"}, {"title": "objx"}],
+		"users": {"tyler": {"email": "t@example.com"}, "mat": {"email": "m@example.com"}},
+		"tags": ["one", "two"]
+	}`)
+
+	m.Set("books[*].title", "untitled")
+	m.Set("books[*].meta.read", true)
+	m.Set("users.*.email", "")
+	m.Set("tags[*]", "tag")
+
+	assert.Equal(t, []interface{}{"untitled", "untitled"}, m.Get("books[*].title").Data())
+	assert.Equal(t, []interface{}{true, true}, m.Get("books[*].meta.read").Data())
+	assert.Equal(t, []interface{}{"", ""}, m.Get("users.*.email").Data())
+	assert.Equal(t, []interface{}{"tag", "tag"}, m.Get("tags").Data())
+}
+
+func TestAccessorsRecursiveDescent(t *testing.T) {
+	m := objx.MustFromJSON(`{
+		"id": 1,
+		"resources": [
+			{"id": 2, "arn": "a", "tags": [{"id": 3}]},
+			{"type": "bucket", "nested": {"arn": "b", "id": 4}}
+		],
+		"other": {"arn": "c"}
+	}`)
+
+	assert.Equal(t, []interface{}{float64(1), float64(2), float64(3), float64(4)}, m.Get("..id").Data())
+	assert.Equal(t, []interface{}{"a", "b"}, m.Get("resources..arn").Data())
+	assert.Equal(t, []interface{}{"c", "a", "b"}, m.Get("..arn").Data())
+	assert.Equal(t, []interface{}{float64(3)}, m.Get("..tags[0].id").Data())
+	assert.Equal(t, []interface{}{"bucket"}, m.Get("resources..[type]").Data())
+	assert.Equal(t, []interface{}{}, m.Get("..missing").Data())
+	assert.True(t, m.Has("..arn"))
+	assert.False(t, m.Has("..missing"))
+}
+
+func TestAccessorsSetRecursiveDescent(t *testing.T) {
+	m := objx.MustFromJSON(`{
+		"resources": [
+			{"id": 2, "tags": [{"id": 3}]},
+			{"nested": {"id": 4}}
+		]
+	}`)
+
+	m.Set("..id", 0)
+	m.Set("..name.first", "none")
+
+	assert.Equal(t, []interface{}{0, 0, 0}, m.Get("..id").Data())
+	assert.Equal(t, []interface{}{}, m.Get("..name").Data())
+	assert.False(t, m.Has("resources[1].id"))
+}
+
+func TestAccessorsNegativeIndex(t *testing.T) {
+	m := objx.Map{
+		"books": []interface{}{
+			objx.Map{"title": "Go"},
+			objx.Map{"title": "objx"},
+		},
+		"tags": []string{"one", "two", "three"},
+		"-1":   "key",
+	}
+
+	assert.Equal(t, "objx", m.Get("books[-1].title").Data())
+	assert.Equal(t, "Go", m.Get("books[-2].title").Data())
+	assert.Nil(t, m.Get("books[-3]").Data())
+	assert.Equal(t, "three", m.Get("tags[-1]").Data())
+	assert.Equal(t, "key", m.Get(`["-1"]`).Data())
+
+	m.Set("tags[-1]", "four")
+	m.Set("books[-1].title", "testify")
+
+	assert.Equal(t, []string{"one", "two", "four"}, m.Get("tags").Data())
+	assert.Equal(t, "testify", m.Get("books[1].title").Data())
+}
+
+func TestAccessorsRange(t *testing.T) {
+	m := objx.Map{
+		"ints":  []int{0, 1, 2, 3, 4, 5},
+		"items": []interface{}{"a", "b", "c", "d"},
+		"books": []interface{}{
+			objx.Map{"title": "Go"},
+			objx.Map{"title": "objx"},
+			objx.Map{"title": "testify"},
+		},
+		"host:port": "localhost:80",
+	}
+
+	assert.Equal(t, []int{2, 3, 4}, m.Get("ints[2:5]").IntSlice())
+	assert.Equal(t, []int{0, 1}, m.Get("ints[:2]").IntSlice())
+	assert.Equal(t, []int{4, 5}, m.Get("ints[-2:]").IntSlice())
+	assert.Equal(t, []int{0, 2, 4}, m.Get("ints[::2]").IntSlice())
+	assert.Equal(t, []int{5, 4, 3, 2, 1, 0}, m.Get("ints[::-1]").IntSlice())
+	assert.Equal(t, []int{4, 2}, m.Get("ints[4:1:-2]").IntSlice())
+	assert.Equal(t, []int{}, m.Get("ints[4:2]").IntSlice())
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5}, m.Get("ints[-100:100]").IntSlice())
+	assert.Equal(t, []interface{}{"b", "c"}, m.Get("items[1:3]").Data())
+	assert.Equal(t, "c", m.Get("items[1:3][1]").Data())
+	assert.Equal(t, []interface{}{"objx", "testify"}, m.Get("books[1:][*].title").Data())
+	assert.Equal(t, "localhost:80", m.Get("[host:port]").Data())
+	assert.Nil(t, m.Get("host:port[0:1]").Data())
+}
+
+func TestAccessorsRangeWithError(t *testing.T) {
+	m := objx.Map{"ints": []int{0, 1, 2}}
+
+	assert.Nil(t, m.Get("ints[::0]").Data())
+	assert.Nil(t, m.Get("ints[99999999999999999999:]").Data())
+}
+
+func TestAccessorsRangeWithHugeStep(t *testing.T) {
+	m := objx.Map{"a": []interface{}{1, 2, 3}}
+
+	assert.Equal(t, []interface{}{2}, m.Get("a[1::9223372036854775807]").Data())
+	assert.Equal(t, []interface{}{3}, m.Get("a[::-9223372036854775808]").Data())
+
+	removed, ok := m.Delete("a[1::9223372036854775807]")
+	assert.True(t, ok)
+	assert.Equal(t, []interface{}{2}, removed.Data())
+	assert.Equal(t, []interface{}{1, 3}, m.Get("a").Data())
+}
+
+func TestAccessorsSetRange(t *testing.T) {
+	m := objx.Map{
+		"tags":  []string{"one", "two", "three"},
+		"items": []interface{}{1, 2, 3, 4},
+	}
+
+	m.Set("tags[1:]", "none")
+	m.Set("items[::2]", 0)
+	m.Set("items[1::9223372036854775807]", 5)
+
+	assert.Equal(t, []string{"one", "none", "none"}, m.Get("tags").Data())
+	assert.Equal(t, []interface{}{0, 5, 0, 4}, m.Get("items").Data())
+
+	m.Set("tags[0:1]", 1)
+	assert.Equal(t, "one", m.Get("tags[0]").Data())
+}
+
+func TestAccessorsDelete(t *testing.T) {
+	m := objx.Map{
+		"a": objx.Map{
+			"b": []interface{}{
+				objx.Map{"c": 1},
+				objx.Map{"c": 2},
+				objx.Map{"c": 3, "d": 4},
+			},
+		},
+		"tags": []string{"one", "two", "three"},
+	}
+
+	removed, ok := m.Delete("a.b[2].c")
+	assert.True(t, ok)
+	assert.Equal(t, 3, removed.Data())
+	assert.Equal(t, objx.Map{"d": 4}, m.Get("a.b[2]").Data())
+
+	removed, ok = m.Delete("tags[-1]")
+	assert.True(t, ok)
+	assert.Equal(t, "three", removed.Data())
+	assert.Equal(t, []string{"one", "two"}, m.Get("tags").Data())
+
+	removed, ok = m.Delete("a.b[0]")
+	assert.True(t, ok)
+	assert.Equal(t, objx.Map{"c": 1}, removed.Data())
+	assert.Equal(t, 2, len(m.Get("a.b").InterSlice()))
+
+	removed, ok = m.Delete("a.b.c")
+	assert.False(t, ok)
+	assert.Nil(t, removed.Data())
+
+	_, ok = m.Delete("a.missing")
+	assert.False(t, ok)
+	_, ok = m.Delete("a[")
+	assert.False(t, ok)
+
+	removed, ok = m.Delete("tags")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"one", "two"}, removed.Data())
+	assert.False(t, m.Has("tags"))
+}
+
+func TestAccessorsDeleteMulti(t *testing.T) {
+	m := objx.Map{
+		"books": []interface{}{
+			objx.Map{"title": "Go", "price": 20, "id": 1},
+			objx.Map{"title": "objx", "price": 5, "id": 2},
+			objx.Map{"title": "testify", "price": 15, "id": 3},
+		},
+		"ints": []int{0, 1, 2, 3, 4, 5},
+		"id":   0,
+	}
+
+	removed, ok := m.Delete("books[?(@.price > 10)]")
+	assert.True(t, ok)
+	assert.Equal(t, 2, len(removed.InterSlice()))
+	assert.Equal(t, []interface{}{"objx"}, m.Get("books[*].title").Data())
+
+	removed, ok = m.Delete("..id")
+	assert.True(t, ok)
+	assert.Equal(t, []interface{}{0, 2}, removed.Data())
+	assert.False(t, m.Has("..id"))
+
+	removed, ok = m.Delete("ints[::2]")
+	assert.True(t, ok)
+	assert.Equal(t, []int{0, 2, 4}, removed.Data())
+	assert.Equal(t, []int{1, 3, 5}, m.Get("ints").Data())
+
+	removed, ok = m.Delete("books[*].missing")
+	assert.False(t, ok)
+	assert.Equal(t, []interface{}{}, removed.Data())
+}
+
+func TestAccessorsTypedMaps(t *testing.T) {
+	m := objx.Map{
+		"labels": map[string]string{"app": "objx"},
+		"ports":  map[string][]int{"http": {80, 8080}},
+		"yaml": map[interface{}]interface{}{
+			"name": "objx",
+			1:      "one",
+			"nested": map[interface{}]interface{}{
+				"key": "value",
+			},
+		},
+		"codes": map[int]string{404: "not found"},
+	}
+
+	assert.Equal(t, "objx", m.Get("labels.app").Data())
+	assert.Equal(t, 8080, m.Get("ports.http[1]").Data())
+	assert.Equal(t, "objx", m.Get("yaml.name").Data())
+	assert.Equal(t, "one", m.Get("yaml.1").Data())
+	assert.Equal(t, "value", m.Get("yaml.nested.key").Data())
+	assert.Equal(t, "not found", m.Get("codes.404").Data())
+	assert.Equal(t, []interface{}{"objx"}, m.Get("labels.*").Data())
+	assert.Equal(t, []interface{}{"value"}, m.Get("..key").Data())
+	assert.Nil(t, m.Get("labels.nope").Data())
+	assert.Nil(t, m.Get("codes.nope").Data())
+
+	m.Set("labels.tier", "backend")
+	m.Set("labels.port", 80)
+	m.Set("ports.http[2]", 8443)
+	m.Set("yaml.nested.other", 2)
+	m.Set("codes.500", "server error")
+
+	assert.Equal(t, map[string]string{"app": "objx", "tier": "backend"}, m.Get("labels").Data())
+	assert.Equal(t, []int{80, 8080, 8443}, m.Get("ports.http").Data())
+	assert.Equal(t, 2, m.Get("yaml.nested.other").Data())
+	assert.Equal(t, "server error", m.Get("codes.500").Data())
+
+	removed, ok := m.Delete("yaml.1")
+	assert.True(t, ok)
+	assert.Equal(t, "one", removed.Data())
+	assert.False(t, m.Exists("yaml.1"))
+	_, ok = m.Delete("ports.http[0]")
+	assert.True(t, ok)
+	assert.Equal(t, []int{8080, 8443}, m.Get("ports.http").Data())
+}
+
+type testAuthor struct {
+	Name   string `json:"name"`
+	Email  string `json:"email,omitempty"`
+	Secret string `json:"-"`
+	Age    int
+	hidden string
+}
+
+type testBook struct {
+	*testAuthor
+	Title    string                 `json:"title"`
+	Tags     []string               `json:"tags"`
+	Extra    map[string]interface{} `json:"extra"`
+	Coauthor *testAuthor            `json:"coauthor"`
+}
+
+func TestAccessorsStructs(t *testing.T) {
+	book := &testBook{
+		testAuthor: &testAuthor{Name: "Mat", Secret: "s3cr3t", Age: 29, hidden: "x"},
+		Title:      "objx",
+		Tags:       []string{"go"},
+		Extra:      map[string]interface{}{"pages": 100},
+	}
+	m := objx.Map{"book": book}
+
+	assert.Equal(t, "objx", m.Get("book.title").Data())
+	assert.Equal(t, "Mat", m.Get("book.name").Data())
+	assert.Equal(t, 29, m.Get("book.Age").Data())
+	assert.Equal(t, "go", m.Get("book.tags[0]").Data())
+	assert.Equal(t, 100, m.Get("book.extra.pages").Data())
+	assert.Nil(t, m.Get("book.Title").Data())
+	assert.False(t, m.Exists("book.Secret"))
+	assert.False(t, m.Exists("book.hidden"))
+	assert.False(t, m.Exists("book.coauthor.name"))
+	assert.True(t, m.Exists("book.coauthor"))
+	assert.Equal(t, []interface{}{"Mat"}, m.Get("..name").Data())
+
+	m.Set("book.title", "Go")
+	m.Set("book.email", "mat@example.com")
+	m.Set("book.tags[1]", "objx")
+	m.Set("book.extra.year", 2013)
+	m.Set("book.Age", "old")
+
+	assert.Equal(t, "Go", book.Title)
+	assert.Equal(t, "mat@example.com", book.Email)
+	assert.Equal(t, []string{"go", "objx"}, book.Tags)
+	assert.Equal(t, 2013, book.Extra["year"])
+	assert.Equal(t, 29, book.Age)
+
+	_, ok := m.Delete("book.title")
+	assert.False(t, ok)
+
+	byValue := objx.Map{"book": testBook{Title: "objx"}}
+	byValue.Set("book.title", "Go")
+	assert.Equal(t, "objx", byValue.Get("book.title").Data())
+}
+
+type testNode struct {
+	*testNode
+	X int
+}
+
+func TestAccessorsRecursiveEmbeddedStruct(t *testing.T) {
+	m := objx.Map{"n": &testNode{testNode: &testNode{X: 2}, X: 1}}
+
+	assert.Equal(t, 1, m.Get("n.X").Data())
+	assert.Equal(t, []interface{}{1}, m.Get("..X").Data())
+	assert.False(t, m.Exists("n.testNode"))
+
+	m.Set("n.X", 3)
+	assert.Equal(t, 3, m.Get("n.X").Data())
+}
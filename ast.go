@@ -0,0 +1,162 @@
+package objx
+
+import (
+	"strconv"
+	"strings"
+)
+
+// SegmentKind describes what a segment of a parsed selector addresses.
+type SegmentKind int
+
+const (
+	// SegmentKey addresses a key of a map, e.g. `title` or `["a.b"]`
+	SegmentKey SegmentKind = iota
+	// SegmentIndex addresses an element of an array, e.g. `[1]` or `[-1]`
+	SegmentIndex
+	// SegmentWildcard addresses every element of an array or map, e.g. `*`
+	SegmentWildcard
+	// SegmentRange addresses a part of an array, e.g. `[1:3]`
+	SegmentRange
+	// SegmentFilter addresses the elements of an array or map matching a
+	// predicate, e.g. `[?(@.price < 10)]`
+	SegmentFilter
+	// SegmentDescent addresses a value and all of its descendants, e.g. `..`
+	SegmentDescent
+)
+
+// String returns the name of the kind.
+func (k SegmentKind) String() string {
+	switch k {
+	case SegmentKey:
+		return "key"
+	case SegmentIndex:
+		return "index"
+	case SegmentWildcard:
+		return "wildcard"
+	case SegmentRange:
+		return "range"
+	case SegmentFilter:
+		return "filter"
+	case SegmentDescent:
+		return "descent"
+	}
+	return "SegmentKind(" + strconv.Itoa(int(k)) + ")"
+}
+
+// Segment is a single step of a parsed selector.
+type Segment struct {
+	// Kind is what the segment addresses
+	Kind SegmentKind
+	// Key is the unquoted key of a SegmentKey
+	Key string
+	// Index is the index of a SegmentIndex
+	Index int
+	// Start and End are the optional bounds of a SegmentRange
+	Start, End *int
+	// Step is the step of a SegmentRange
+	Step int
+	// Filter is the canonical predicate of a SegmentFilter, e.g.
+	// `@.price < 10`
+	Filter string
+	// Offset is the position in bytes of the segment in the selector
+	Offset int
+}
+
+// SelectorAST is a parsed selector.
+type SelectorAST struct {
+	// Segments are the steps of the selector
+	Segments []Segment
+	// sep separates the keys of the selector
+	sep string
+}
+
+// ParseSelector parses the selector using the options and returns its
+// segments, without compiling it.
+//
+// It can be used to validate selectors typed by users:
+//
+//	if _, err := objx.ParseSelector(input); err != nil {
+//		var syntaxErr *objx.SyntaxError
+//		if errors.As(err, &syntaxErr) {
+//			// syntaxErr.Offset is where the selector is malformed
+//		}
+//	}
+//
+// Returns a *SyntaxError if the selector is malformed.
+func ParseSelector(selector string, options ...Option) (SelectorAST, error) {
+	o, err := newOptions(options)
+	if err != nil {
+		return SelectorAST{}, err
+	}
+	segments, err := parseSelector(selector, o.sep[0])
+	if err != nil {
+		return SelectorAST{}, err
+	}
+
+	ast := SelectorAST{Segments: make([]Segment, len(segments)), sep: o.sep}
+	for i, seg := range segments {
+		s := Segment{Offset: seg.offset}
+		switch seg.kind {
+		case segmentKey:
+			s.Kind, s.Key = SegmentKey, seg.key
+		case segmentIndex:
+			s.Kind, s.Index = SegmentIndex, seg.index
+		case segmentWildcard:
+			s.Kind = SegmentWildcard
+		case segmentRange:
+			s.Kind, s.Start, s.End, s.Step = SegmentRange, seg.start, seg.end, seg.step
+		case segmentFilter:
+			s.Kind, s.Filter = SegmentFilter, seg.filter.format(o.sep)
+		case segmentDescent:
+			s.Kind = SegmentDescent
+		}
+		ast.Segments[i] = s
+	}
+	return ast, nil
+}
+
+// String returns the canonical form of the selector: keys are only
+// quoted when needed, wildcards and filters are enclosed in brackets and
+// filters are normalized, e.g. `users.*["e-mail"]` becomes `users[*].e-mail`.
+//
+// The canonical form selects the same values as the original selector.
+func (a SelectorAST) String() string {
+	sep := a.sep
+	if sep == "" {
+		sep = PathSeparator
+	}
+
+	var b strings.Builder
+	first := true
+	for _, seg := range a.Segments {
+		switch seg.Kind {
+		case SegmentKey:
+			b.WriteString(formatKey(seg.Key, sep, first))
+		case SegmentIndex:
+			b.WriteString("[" + strconv.Itoa(seg.Index) + "]")
+		case SegmentWildcard:
+			b.WriteString("[*]")
+		case SegmentRange:
+			b.WriteByte('[')
+			if seg.Start != nil {
+				b.WriteString(strconv.Itoa(*seg.Start))
+			}
+			b.WriteByte(':')
+			if seg.End != nil {
+				b.WriteString(strconv.Itoa(*seg.End))
+			}
+			if seg.Step != 1 && seg.Step != 0 {
+				b.WriteString(":" + strconv.Itoa(seg.Step))
+			}
+			b.WriteByte(']')
+		case SegmentFilter:
+			b.WriteString("[?(" + seg.Filter + ")]")
+		case SegmentDescent:
+			b.WriteString(sep + sep)
+			first = true
+			continue
+		}
+		first = false
+	}
+	return b.String()
+}
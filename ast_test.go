@@ -0,0 +1,79 @@
+package objx_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/objx"
+)
+
+func TestParseSelector(t *testing.T) {
+	ast, err := objx.ParseSelector(`books[1]["a.b"][1:3]..*[?(@.price < 10)]`)
+
+	require.NoError(t, err)
+	require.Len(t, ast.Segments, 7)
+	one, three := 1, 3
+	assert.Equal(t, []objx.Segment{
+		{Kind: objx.SegmentKey, Key: "books", Offset: 0},
+		{Kind: objx.SegmentIndex, Index: 1, Offset: 5},
+		{Kind: objx.SegmentKey, Key: "a.b", Offset: 8},
+		{Kind: objx.SegmentRange, Start: &one, End: &three, Step: 1, Offset: 15},
+		{Kind: objx.SegmentDescent, Offset: 20},
+		{Kind: objx.SegmentWildcard, Offset: 22},
+		{Kind: objx.SegmentFilter, Filter: "@.price < 10", Offset: 23},
+	}, ast.Segments)
+	assert.Equal(t, "range", ast.Segments[3].Kind.String())
+}
+
+func TestParseSelectorWithError(t *testing.T) {
+	for selector, offset := range map[string]int{
+		"a[b":          1,
+		"a.":           1,
+		"a[1]x":        4,
+		`a["b`:         2,
+		"a[?(@.b":      3,
+		"a[::0]":       4,
+		"a]":           1,
+		"a[?(@.b[*])]": 7,
+	} {
+		_, err := objx.ParseSelector(selector)
+
+		var syntaxErr *objx.SyntaxError
+		require.True(t, errors.As(err, &syntaxErr), selector)
+		assert.Equal(t, selector, syntaxErr.Selector)
+		assert.Equal(t, offset, syntaxErr.Offset, selector)
+	}
+
+	_, err := objx.ParseSelector("a.b", objx.Separator("[["))
+	assert.Error(t, err)
+}
+
+func TestSelectorASTString(t *testing.T) {
+	for selector, canonical := range map[string]string{
+		"books[1].title":                   "books[1].title",
+		`books[1]["title"]`:                "books[1].title",
+		`labels['app.kubernetes.io/name']`: `labels["app.kubernetes.io/name"]`,
+		`metrics.latency\[p99\]`:           `metrics["latency[p99]"]`,
+		"users.*.email":                    "users[*].email",
+		"ints[::-1]":                       "ints[::-1]",
+		"ints[1:3:1]":                      "ints[1:3]",
+		"..id":                             "..id",
+		"resources..[0]":                   "resources..[0]",
+		"":                                 `[""]`,
+		`a[?(@.price<10&&(@.b||!@.c))]`:    `a[?(@.price < 10 && (@.b || !@.c))]`,
+		`a[?(@["x y"] == 'it\'s' || @.n >= 1e3)]`: `a[?(@["x y"] == "it's" || @.n >= 1000)]`,
+		`a[?(!(@.a == null))]`:                    `a[?(!(@.a == null))]`,
+	} {
+		ast, err := objx.ParseSelector(selector)
+		require.NoError(t, err, selector)
+		assert.Equal(t, canonical, ast.String(), selector)
+
+		reparsed, err := objx.ParseSelector(ast.String())
+		require.NoError(t, err, selector)
+		assert.Equal(t, canonical, reparsed.String(), selector)
+	}
+
+	ast, err := objx.ParseSelector("hosts/example.com/port", objx.Separator("/"))
+	require.NoError(t, err)
+	assert.Equal(t, "hosts/example.com/port", ast.String())
+}
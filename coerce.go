@@ -0,0 +1,376 @@
+package objx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrOverflow is wrapped by a ConversionError when a number is out of
+	// the range of the requested type.
+	ErrOverflow = errors.New("objx: value out of range")
+	// ErrPrecisionLoss is wrapped by a ConversionError when a number
+	// cannot be represented exactly by the requested type, e.g. because
+	// it has a fractional part.
+	ErrPrecisionLoss = errors.New("objx: loss of precision")
+)
+
+// ConversionError describes why a value could not be coerced to a type.
+type ConversionError struct {
+	// Value is the value being converted
+	Value interface{}
+	// Target is the name of the requested type, e.g. "int"
+	Target string
+	// Err is ErrNotFound, ErrTypeMismatch, ErrOverflow or ErrPrecisionLoss
+	Err error
+}
+
+// Error returns a description of the failed conversion.
+func (e *ConversionError) Error() string {
+	return fmt.Sprintf("objx: cannot convert %#v to %s: %s", e.Value, e.Target, strings.TrimPrefix(e.Err.Error(), "objx: "))
+}
+
+// Unwrap returns the underlying error.
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
+
+// ElementError describes why an element of a slice could not be
+// converted.
+type ElementError struct {
+	// Index is the index of the element
+	Index int
+	// Err is the *ConversionError of the element
+	Err error
+}
+
+// Error returns a description of the failed conversion.
+func (e *ElementError) Error() string {
+	return fmt.Sprintf("objx: element %d: %s", e.Index, strings.TrimPrefix(e.Err.Error(), "objx: "))
+}
+
+// Unwrap returns the underlying error.
+func (e *ElementError) Unwrap() error {
+	return e.Err
+}
+
+// AsInt64 gets the value as an int64, converting it from any numeric
+// type, a json.Number or a numeric string.
+//
+// Unlike Int64, AsInt64 returns a *ConversionError instead of a default
+// if the value is missing, is not numeric, is out of range or has a
+// fractional part:
+//
+//	objx.MustFromJSON(`{"age": 30}`).Get("age").AsInt64() // 30, nil
+//	objx.MustFromJSON(`{"age": 30.5}`).Get("age").AsInt64() // 0, ErrPrecisionLoss
+func (v *Value) AsInt64() (int64, error) {
+	n, err := v.numeric("int64")
+	if err != nil {
+		return 0, err
+	}
+	switch n.kind {
+	case numericInt:
+		return n.i, nil
+	case numericUint:
+		if n.u > math.MaxInt64 {
+			return 0, v.conversionError("int64", ErrOverflow)
+		}
+		return int64(n.u), nil
+	}
+	if err := checkIntegral(n.f, -(1 << 63), 1<<63); err != nil {
+		return 0, v.conversionError("int64", err)
+	}
+	return int64(n.f), nil
+}
+
+// AsInt gets the value as an int, like AsInt64.
+func (v *Value) AsInt() (int, error) {
+	i, err := v.AsInt64()
+	if err != nil {
+		return 0, v.retarget(err, "int")
+	}
+	if int64(int(i)) != i {
+		return 0, v.conversionError("int", ErrOverflow)
+	}
+	return int(i), nil
+}
+
+// AsUint64 gets the value as a uint64, converting it from any numeric
+// type, a json.Number or a numeric string.
+//
+// Returns a *ConversionError if the value is missing, is not numeric, is
+// negative or too large or has a fractional part.
+func (v *Value) AsUint64() (uint64, error) {
+	n, err := v.numeric("uint64")
+	if err != nil {
+		return 0, err
+	}
+	switch n.kind {
+	case numericInt:
+		if n.i < 0 {
+			return 0, v.conversionError("uint64", ErrOverflow)
+		}
+		return uint64(n.i), nil
+	case numericUint:
+		return n.u, nil
+	}
+	if err := checkIntegral(n.f, 0, 1<<64); err != nil {
+		return 0, v.conversionError("uint64", err)
+	}
+	return uint64(n.f), nil
+}
+
+// AsUint gets the value as a uint, like AsUint64.
+func (v *Value) AsUint() (uint, error) {
+	u, err := v.AsUint64()
+	if err != nil {
+		return 0, v.retarget(err, "uint")
+	}
+	if uint64(uint(u)) != u {
+		return 0, v.conversionError("uint", ErrOverflow)
+	}
+	return uint(u), nil
+}
+
+// AsFloat64 gets the value as a float64, converting it from any numeric
+// type, a json.Number or a numeric string.
+//
+// Returns a *ConversionError if the value is missing, is not numeric or
+// is an integer too large to be represented exactly.
+func (v *Value) AsFloat64() (float64, error) {
+	n, err := v.numeric("float64")
+	if err != nil {
+		return 0, err
+	}
+	switch n.kind {
+	case numericInt:
+		f := float64(n.i)
+		if f >= 1<<63 || int64(f) != n.i {
+			return 0, v.conversionError("float64", ErrPrecisionLoss)
+		}
+		return f, nil
+	case numericUint:
+		f := float64(n.u)
+		if f >= 1<<64 || uint64(f) != n.u {
+			return 0, v.conversionError("float64", ErrPrecisionLoss)
+		}
+		return f, nil
+	}
+	if n.overflow {
+		return 0, v.conversionError("float64", ErrOverflow)
+	}
+	return n.f, nil
+}
+
+// AsBool gets the value as a bool. Strings are parsed with
+// strconv.ParseBool and the numbers 0 and 1 are false and true.
+//
+// Returns a *ConversionError if the value is missing or cannot be
+// converted.
+func (v *Value) AsBool() (bool, error) {
+	if b, ok := v.data.(bool); ok {
+		return b, nil
+	}
+	if s, ok := v.data.(string); ok {
+		if b, err := strconv.ParseBool(strings.TrimSpace(s)); err == nil {
+			return b, nil
+		}
+	}
+	i, err := v.AsInt64()
+	if err != nil || (i != 0 && i != 1) {
+		if !v.IsPresent() {
+			return false, v.conversionError("bool", ErrNotFound)
+		}
+		return false, v.conversionError("bool", ErrTypeMismatch)
+	}
+	return i == 1, nil
+}
+
+// AsString gets the value as a string. Numbers and bools are formatted
+// like String does and json.Number is kept as is.
+//
+// Returns a *ConversionError if the value is missing, null or neither a
+// string, a number nor a bool.
+func (v *Value) AsString() (string, error) {
+	switch data := v.data.(type) {
+	case string:
+		return data, nil
+	case json.Number:
+		return data.String(), nil
+	case bool:
+		return strconv.FormatBool(data), nil
+	}
+	if _, ok := toFloat64(v.data); ok {
+		return v.String(), nil
+	}
+	if !v.IsPresent() {
+		return "", v.conversionError("string", ErrNotFound)
+	}
+	return "", v.conversionError("string", ErrTypeMismatch)
+}
+
+// AsInt64Slice gets the value as a []int64, converting each element of
+// a slice like AsInt64.
+//
+// Returns an *ElementError holding the index of the first element that
+// cannot be converted, or a *ConversionError if the value is not a
+// slice:
+//
+//	objx.MustFromJSON(`{"ids": [1, 2]}`).Get("ids").AsInt64Slice() // []int64{1, 2}, nil
+//	objx.MustFromJSON(`{"ids": [1, 2.5]}`).Get("ids").AsInt64Slice() // nil, element 1: ErrPrecisionLoss
+func (v *Value) AsInt64Slice() ([]int64, error) {
+	return convertSlice(v, "[]int64", (*Value).AsInt64)
+}
+
+// AsIntSlice gets the value as a []int, like AsInt64Slice.
+func (v *Value) AsIntSlice() ([]int, error) {
+	return convertSlice(v, "[]int", (*Value).AsInt)
+}
+
+// AsUint64Slice gets the value as a []uint64, like AsInt64Slice.
+func (v *Value) AsUint64Slice() ([]uint64, error) {
+	return convertSlice(v, "[]uint64", (*Value).AsUint64)
+}
+
+// AsUintSlice gets the value as a []uint, like AsInt64Slice.
+func (v *Value) AsUintSlice() ([]uint, error) {
+	return convertSlice(v, "[]uint", (*Value).AsUint)
+}
+
+// AsFloat64Slice gets the value as a []float64, like AsInt64Slice.
+func (v *Value) AsFloat64Slice() ([]float64, error) {
+	return convertSlice(v, "[]float64", (*Value).AsFloat64)
+}
+
+// AsBoolSlice gets the value as a []bool, like AsInt64Slice.
+func (v *Value) AsBoolSlice() ([]bool, error) {
+	return convertSlice(v, "[]bool", (*Value).AsBool)
+}
+
+// AsStringSlice gets the value as a []string, like AsInt64Slice.
+func (v *Value) AsStringSlice() ([]string, error) {
+	return convertSlice(v, "[]string", (*Value).AsString)
+}
+
+// convertSlice converts each element of the slice or array held by v
+// with convert, stopping at the first one that fails.
+func convertSlice[T any](v *Value, target string, convert func(*Value) (T, error)) ([]T, error) {
+	rv := reflect.ValueOf(v.data)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		if !v.IsPresent() {
+			return nil, v.conversionError(target, ErrNotFound)
+		}
+		return nil, v.conversionError(target, ErrTypeMismatch)
+	}
+	result := make([]T, rv.Len())
+	for i := range result {
+		t, err := convert(&Value{data: rv.Index(i).Interface()})
+		if err != nil {
+			return nil, &ElementError{Index: i, Err: err}
+		}
+		result[i] = t
+	}
+	return result, nil
+}
+
+// numericKind is the representation of a numeric value.
+type numericKind int
+
+const (
+	numericInt numericKind = iota
+	numericUint
+	numericFloat
+)
+
+// numericValue is a numeric value in its exact representation.
+type numericValue struct {
+	kind numericKind
+	i    int64
+	u    uint64
+	f    float64
+	// overflow is whether f was parsed from a number out of the range
+	// of a float64 and rounded to an infinity
+	overflow bool
+}
+
+// numeric returns the numeric value held by v, or a *ConversionError to
+// target if there is none.
+func (v *Value) numeric(target string) (numericValue, error) {
+	switch data := v.data.(type) {
+	case string:
+		if n, ok := parseNumeric(strings.TrimSpace(data)); ok {
+			return n, nil
+		}
+	case json.Number:
+		if n, ok := parseNumeric(data.String()); ok {
+			return n, nil
+		}
+	default:
+		rv := reflect.ValueOf(data)
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return numericValue{kind: numericInt, i: rv.Int()}, nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return numericValue{kind: numericUint, u: rv.Uint()}, nil
+		case reflect.Float32, reflect.Float64:
+			return numericValue{kind: numericFloat, f: rv.Float()}, nil
+		}
+	}
+	if !v.IsPresent() {
+		return numericValue{}, v.conversionError(target, ErrNotFound)
+	}
+	return numericValue{}, v.conversionError(target, ErrTypeMismatch)
+}
+
+// parseNumeric parses a decimal integer or a finite floating point
+// number.
+func parseNumeric(s string) (numericValue, bool) {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return numericValue{kind: numericInt, i: i}, true
+	}
+	if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return numericValue{kind: numericUint, u: u}, true
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil && !errors.Is(err, strconv.ErrRange) {
+		return numericValue{}, false
+	}
+	if err == nil && (math.IsNaN(f) || math.IsInf(f, 0)) {
+		// "NaN", "Inf" and the like are not numbers in JSON
+		return numericValue{}, false
+	}
+	return numericValue{kind: numericFloat, f: f, overflow: err != nil}, true
+}
+
+// checkIntegral returns an error unless f is an integer in [min, max).
+func checkIntegral(f, min, max float64) error {
+	switch {
+	case math.IsNaN(f):
+		return ErrPrecisionLoss
+	case f < min || f >= max:
+		return ErrOverflow
+	case f != math.Trunc(f):
+		return ErrPrecisionLoss
+	}
+	return nil
+}
+
+// conversionError returns the error describing why v cannot be converted
+// to target.
+func (v *Value) conversionError(target string, err error) *ConversionError {
+	return &ConversionError{Value: v.data, Target: target, Err: err}
+}
+
+// retarget returns err, a *ConversionError, with the target changed.
+func (v *Value) retarget(err error, target string) error {
+	var conversionErr *ConversionError
+	if errors.As(err, &conversionErr) {
+		return v.conversionError(target, conversionErr.Err)
+	}
+	return err
+}
@@ -0,0 +1,198 @@
+package objx_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/objx"
+)
+
+func TestAsInt(t *testing.T) {
+	m := objx.MustFromJSON(`{"age": 30, "neg": -2, "exp": 1e3, "half": 30.5, "big": 1e300, "str": " 42 ", "fstr": "4.0", "word": "abc", "null": null, "bool": true}`)
+	m["int8"] = int8(-8)
+	m["uint64"] = uint64(math.MaxUint64)
+	m["number"] = json.Number("9007199254740993")
+
+	for selector, expected := range map[string]int64{
+		"age":    30,
+		"neg":    -2,
+		"exp":    1000,
+		"str":    42,
+		"fstr":   4,
+		"int8":   -8,
+		"number": 9007199254740993,
+	} {
+		i, err := m.Get(selector).AsInt64()
+
+		assert.NoError(t, err, selector)
+		assert.Equal(t, expected, i, selector)
+	}
+
+	for selector, expected := range map[string]error{
+		"half":    objx.ErrPrecisionLoss,
+		"big":     objx.ErrOverflow,
+		"uint64":  objx.ErrOverflow,
+		"word":    objx.ErrTypeMismatch,
+		"null":    objx.ErrTypeMismatch,
+		"bool":    objx.ErrTypeMismatch,
+		"missing": objx.ErrNotFound,
+	} {
+		i, err := m.Get(selector).AsInt64()
+
+		assert.Equal(t, int64(0), i, selector)
+		assert.True(t, errors.Is(err, expected), selector)
+	}
+
+	i, err := m.Get("age").AsInt()
+	assert.NoError(t, err)
+	assert.Equal(t, 30, i)
+
+	_, err = m.Get("half").AsInt()
+	var conversionErr *objx.ConversionError
+	require.True(t, errors.As(err, &conversionErr))
+	assert.Equal(t, "int", conversionErr.Target)
+	assert.Equal(t, 30.5, conversionErr.Value)
+	assert.Equal(t, "objx: cannot convert 30.5 to int: loss of precision", err.Error())
+}
+
+func TestAsUint(t *testing.T) {
+	m := objx.MustFromJSON(`{"age": 30, "neg": -2, "big": "18446744073709551615", "huge": 1e20}`)
+
+	u, err := m.Get("big").AsUint64()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(math.MaxUint64), u)
+
+	ui, err := m.Get("age").AsUint()
+	assert.NoError(t, err)
+	assert.Equal(t, uint(30), ui)
+
+	_, err = m.Get("neg").AsUint()
+	assert.True(t, errors.Is(err, objx.ErrOverflow))
+	_, err = m.Get("huge").AsUint64()
+	assert.True(t, errors.Is(err, objx.ErrOverflow))
+}
+
+func TestAsFloat64(t *testing.T) {
+	m := objx.Map{
+		"float":  1.5,
+		"int":    3,
+		"str":    "2.5e1",
+		"number": json.Number("0.1"),
+		"big":    int64(1<<53 + 1),
+		"word":   "NaN?",
+	}
+
+	for selector, expected := range map[string]float64{
+		"float":  1.5,
+		"int":    3,
+		"str":    25,
+		"number": 0.1,
+	} {
+		f, err := m.Get(selector).AsFloat64()
+
+		assert.NoError(t, err, selector)
+		assert.Equal(t, expected, f, selector)
+	}
+
+	_, err := m.Get("big").AsFloat64()
+	assert.True(t, errors.Is(err, objx.ErrPrecisionLoss))
+	_, err = objx.Map{"huge": json.Number("1e400")}.Get("huge").AsFloat64()
+	assert.True(t, errors.Is(err, objx.ErrOverflow))
+	_, err = m.Get("word").AsFloat64()
+	assert.True(t, errors.Is(err, objx.ErrTypeMismatch))
+
+	for _, s := range []string{"NaN", "inf", "-Infinity", "+Inf"} {
+		f, err := objx.Map{"s": s}.Get("s").AsFloat64()
+
+		assert.Equal(t, 0.0, f, s)
+		assert.True(t, errors.Is(err, objx.ErrTypeMismatch), s)
+		_, err = objx.Map{"n": json.Number(s)}.Get("n").AsInt64()
+		assert.True(t, errors.Is(err, objx.ErrTypeMismatch), s)
+	}
+}
+
+func TestAsBool(t *testing.T) {
+	m := objx.Map{"t": true, "s": "false", "one": 1.0, "zero": json.Number("0"), "two": 2, "word": "yes"}
+
+	for selector, expected := range map[string]bool{"t": true, "s": false, "one": true, "zero": false} {
+		b, err := m.Get(selector).AsBool()
+
+		assert.NoError(t, err, selector)
+		assert.Equal(t, expected, b, selector)
+	}
+
+	_, err := m.Get("two").AsBool()
+	assert.True(t, errors.Is(err, objx.ErrTypeMismatch))
+	_, err = m.Get("word").AsBool()
+	assert.True(t, errors.Is(err, objx.ErrTypeMismatch))
+	_, err = m.Get("missing").AsBool()
+	assert.True(t, errors.Is(err, objx.ErrNotFound))
+}
+
+func TestAsString(t *testing.T) {
+	m := objx.Map{"s": "Mat", "f": 1.5, "i": 3, "b": true, "n": json.Number("12345678901234567890"), "null": nil, "map": objx.Map{}}
+
+	for selector, expected := range map[string]string{"s": "Mat", "f": "1.5", "i": "3", "b": "true", "n": "12345678901234567890"} {
+		s, err := m.Get(selector).AsString()
+
+		assert.NoError(t, err, selector)
+		assert.Equal(t, expected, s, selector)
+	}
+
+	_, err := m.Get("null").AsString()
+	assert.True(t, errors.Is(err, objx.ErrTypeMismatch))
+	_, err = m.Get("map").AsString()
+	assert.True(t, errors.Is(err, objx.ErrTypeMismatch))
+	_, err = m.Get("missing").AsString()
+	assert.True(t, errors.Is(err, objx.ErrNotFound))
+}
+
+func TestAsSlices(t *testing.T) {
+	m := objx.MustFromJSON(`{"ids": [1, "2", 3], "flags": [true, "false", 1], "names": ["Mat", 30], "ratios": [0.5, 2], "bad": [1, 2, 3.5], "neg": [1, -1], "name": "Mat"}`)
+	m["typed"] = []int32{4, 5}
+
+	ids, err := m.Get("ids").AsInt64Slice()
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, ids)
+
+	ints, err := m.Get("typed").AsIntSlice()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{4, 5}, ints)
+
+	flags, err := m.Get("flags").AsBoolSlice()
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true, false, true}, flags)
+
+	names, err := m.Get("names").AsStringSlice()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Mat", "30"}, names)
+
+	ratios, err := m.Get("ratios").AsFloat64Slice()
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{0.5, 2}, ratios)
+
+	uints, err := m.Get("ids").AsUintSlice()
+	assert.NoError(t, err)
+	assert.Equal(t, []uint{1, 2, 3}, uints)
+
+	bad, err := m.Get("bad").AsIntSlice()
+	assert.Nil(t, bad)
+	var elementErr *objx.ElementError
+	require.True(t, errors.As(err, &elementErr))
+	assert.Equal(t, 2, elementErr.Index)
+	assert.True(t, errors.Is(err, objx.ErrPrecisionLoss))
+	assert.Equal(t, "objx: element 2: cannot convert 3.5 to int: loss of precision", err.Error())
+
+	_, err = m.Get("neg").AsUint64Slice()
+	require.True(t, errors.As(err, &elementErr))
+	assert.Equal(t, 1, elementErr.Index)
+	assert.True(t, errors.Is(err, objx.ErrOverflow))
+
+	_, err = m.Get("name").AsStringSlice()
+	assert.True(t, errors.Is(err, objx.ErrTypeMismatch))
+	assert.False(t, errors.As(err, &elementErr))
+	_, err = m.Get("missing").AsStringSlice()
+	assert.True(t, errors.Is(err, objx.ErrNotFound))
+}
@@ -0,0 +1,293 @@
+package objx
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// isKeyed returns whether v holds a map or a struct, or a pointer to one,
+// whose values can be accessed by key.
+func isKeyed(v interface{}) bool {
+	if isMSI(v) {
+		return true
+	}
+	switch indirect(reflect.ValueOf(v)).Kind() {
+	case reflect.Map, reflect.Struct:
+		return true
+	}
+	return false
+}
+
+// keysOf returns the keys of the map or struct held in v and whether v
+// holds one. Map keys are sorted in ascending order and struct fields
+// come in declaration order.
+func keysOf(v interface{}) ([]string, bool) {
+	if m, ok := toMSI(v); ok {
+		return sortedKeys(m), true
+	}
+
+	rv := indirect(reflect.ValueOf(v))
+	switch rv.Kind() {
+	case reflect.Map:
+		keys := make([]string, 0, rv.Len())
+		for _, k := range rv.MapKeys() {
+			keys = append(keys, fmt.Sprint(k.Interface()))
+		}
+		sort.Strings(keys)
+		return keys, true
+	case reflect.Struct:
+		var keys []string
+		for _, field := range structFields(rv.Type()) {
+			if _, ok := fieldByIndex(rv, field.index); ok {
+				keys = append(keys, field.name)
+			}
+		}
+		return keys, true
+	}
+	return nil, false
+}
+
+// getKey returns the value at key of the map or struct held in v.
+func getKey(v interface{}, key string) (interface{}, bool) {
+	if m, ok := toMSI(v); ok {
+		value, ok := m[key]
+		return value, ok
+	}
+
+	rv := indirect(reflect.ValueOf(v))
+	switch rv.Kind() {
+	case reflect.Map:
+		k, ok := mapKey(rv, key)
+		if !ok {
+			return nil, false
+		}
+		value := rv.MapIndex(k)
+		if !value.IsValid() {
+			return nil, false
+		}
+		return value.Interface(), true
+	case reflect.Struct:
+		field, ok := structField(rv, key)
+		if !ok {
+			return nil, false
+		}
+		return field.Interface(), true
+	}
+	return nil, false
+}
+
+// setKey sets the value at key of the map or struct held in v. It does
+// nothing if the value does not fit, the key cannot be converted to the
+// key type of the map or the struct is not addressable.
+func setKey(v interface{}, key string, value interface{}) bool {
+	if m, ok := toMSI(v); ok {
+		m[key] = value
+		return true
+	}
+
+	rv := indirect(reflect.ValueOf(v))
+	switch rv.Kind() {
+	case reflect.Map:
+		k, ok := mapKey(rv, key)
+		if !ok || rv.IsNil() {
+			return false
+		}
+		val, ok := assignable(value, rv.Type().Elem())
+		if !ok {
+			return false
+		}
+		rv.SetMapIndex(k, val)
+		return true
+	case reflect.Struct:
+		field, ok := structField(rv, key)
+		if !ok || !field.CanSet() {
+			return false
+		}
+		val, ok := assignable(value, field.Type())
+		if !ok {
+			return false
+		}
+		field.Set(val)
+		return true
+	}
+	return false
+}
+
+// deleteKey removes key from the map held in v. Struct fields cannot be
+// removed.
+func deleteKey(v interface{}, key string) bool {
+	if m, ok := toMSI(v); ok {
+		delete(m, key)
+		return true
+	}
+
+	rv := indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Map {
+		return false
+	}
+	k, ok := mapKey(rv, key)
+	if !ok {
+		return false
+	}
+	rv.SetMapIndex(k, reflect.Value{})
+	return true
+}
+
+// indirect follows the pointers held in rv.
+func indirect(rv reflect.Value) reflect.Value {
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	return rv
+}
+
+// assignable returns value as a reflect.Value of type t, or false if it
+// cannot be assigned to it. A nil value is the zero value of t.
+func assignable(value interface{}, t reflect.Type) (reflect.Value, bool) {
+	if value == nil {
+		return reflect.Zero(t), true
+	}
+	val := reflect.ValueOf(value)
+	return val, val.Type().AssignableTo(t)
+}
+
+// mapKey returns the key of the map held in rv matching key.
+//
+// Keys that are not strings match by their string form. If there is no
+// such key yet, key is converted to the key type of the map.
+func mapKey(rv reflect.Value, key string) (reflect.Value, bool) {
+	t := rv.Type().Key()
+	if t.Kind() == reflect.String {
+		return reflect.ValueOf(key).Convert(t), true
+	}
+	if t.Kind() == reflect.Interface {
+		if k := reflect.ValueOf(key); rv.MapIndex(k).IsValid() {
+			return k, true
+		}
+	}
+
+	for _, k := range rv.MapKeys() {
+		if fmt.Sprint(k.Interface()) == key {
+			return k, true
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.Interface:
+		if reflect.TypeOf(key).AssignableTo(t) {
+			return reflect.ValueOf(key), true
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n, err := strconv.ParseInt(key, 10, t.Bits()); err == nil {
+			return reflect.ValueOf(n).Convert(t), true
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n, err := strconv.ParseUint(key, 10, t.Bits()); err == nil {
+			return reflect.ValueOf(n).Convert(t), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// fieldInfo describes a struct field accessible by key.
+type fieldInfo struct {
+	// name is the key of the field, taken from its json tag if any
+	name string
+	// index is the index sequence of the field for FieldByIndex
+	index []int
+}
+
+// fieldCache holds the fields of the struct types seen so far.
+var fieldCache sync.Map
+
+// structFields returns the fields of the struct type t accessible by key,
+// following the rules of encoding/json: unexported and `json:"-"` fields
+// are left out and the fields of embedded structs are promoted.
+func structFields(t reflect.Type) []fieldInfo {
+	if fields, ok := fieldCache.Load(t); ok {
+		return fields.([]fieldInfo)
+	}
+
+	var fields []fieldInfo
+	seen := map[string]bool{}
+	// visited stops the walk at a struct embedding itself
+	visited := map[reflect.Type]bool{}
+	var walk func(t reflect.Type, index []int)
+	walk = func(t reflect.Type, index []int) {
+		if visited[t] {
+			return
+		}
+		visited[t] = true
+		var embedded [][]int
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			tag := f.Tag.Get("json")
+			if tag == "-" {
+				continue
+			}
+			name, _, _ := strings.Cut(tag, ",")
+			fieldIndex := append(append([]int(nil), index...), i)
+
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if f.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+				embedded = append(embedded, fieldIndex)
+				continue
+			}
+			if !f.IsExported() {
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+			if !seen[name] {
+				seen[name] = true
+				fields = append(fields, fieldInfo{name: name, index: fieldIndex})
+			}
+		}
+		for _, fieldIndex := range embedded {
+			ft := t.Field(fieldIndex[len(fieldIndex)-1]).Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			walk(ft, fieldIndex)
+		}
+	}
+	walk(t, nil)
+
+	fieldCache.Store(t, fields)
+	return fields
+}
+
+// structField returns the field of the struct held in rv matching key.
+func structField(rv reflect.Value, key string) (reflect.Value, bool) {
+	for _, field := range structFields(rv.Type()) {
+		if field.name == key {
+			return fieldByIndex(rv, field.index)
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// fieldByIndex returns the nested field of the struct held in rv, or false
+// if it is inside a nil embedded pointer.
+func fieldByIndex(rv reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 {
+			if rv.Kind() == reflect.Ptr {
+				if rv.IsNil() {
+					return reflect.Value{}, false
+				}
+				rv = rv.Elem()
+			}
+		}
+		rv = rv.Field(x)
+	}
+	return rv, true
+}
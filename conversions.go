@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/url"
 	"strconv"
+	"time"
 )
 
 // SignatureSeparator is the character that is used to
@@ -47,12 +48,17 @@ func SetURLValuesSliceKeySuffix(s string) error {
 
 // JSON converts the contained object to a JSON string
 // representation
+//
+// Keys holding an explicit null are encoded as null, while missing keys
+// are left out. Times are encoded with time.RFC3339Nano and durations as
+// strings like "1m30s", the way String and URLValues render them.
 func (m Map) JSON() (string, error) {
-	for k, v := range m {
-		m[k] = cleanUp(v)
+	var cleaned Map
+	if m != nil {
+		cleaned = cleanUpStringMap(m)
 	}
 
-	result, err := json.Marshal(m)
+	result, err := json.Marshal(cleaned)
 	if err != nil {
 		err = errors.New("objx: JSON encode failed with: " + err.Error())
 	}
@@ -107,10 +113,16 @@ func cleanUp(v interface{}) interface{} {
 		return cleanUpMSIArray(v)
 	case map[interface{}]interface{}:
 		return cleanUpInterfaceMap(v)
+	case map[string]interface{}:
+		return cleanUpStringMap(v)
 	case Map:
 		return cleanUpStringMap(v)
 	case []Map:
 		return cleanUpMapArray(v)
+	case time.Duration:
+		return v.String()
+	case []time.Duration:
+		return (&Value{data: v}).StringSlice()
 	default:
 		return v
 	}
@@ -185,6 +197,10 @@ func (m Map) MustSignedBase64(key string) string {
 
 // URLValues creates a url.Values object from an Obj. This
 // function requires that the wrapped object be a map[string]interface{}
+//
+// Keys holding an explicit null are encoded with an empty value, like
+// empty strings. Use ExcludeNulls first to leave them out. Times and
+// durations are rendered like String does.
 func (m Map) URLValues() url.Values {
 	vals := make(url.Values)
 
@@ -245,7 +261,8 @@ func (m Map) parseURLValues(queryMap Map, vals url.Values, key string) {
 		case val.IsStrSlice(), val.IsBoolSlice(),
 			val.IsFloat32Slice(), val.IsFloat64Slice(),
 			val.IsIntSlice(), val.IsInt8Slice(), val.IsInt16Slice(), val.IsInt32Slice(), val.IsInt64Slice(),
-			val.IsUintSlice(), val.IsUint8Slice(), val.IsUint16Slice(), val.IsUint32Slice(), val.IsUint64Slice():
+			val.IsUintSlice(), val.IsUint8Slice(), val.IsUint16Slice(), val.IsUint32Slice(), val.IsUint64Slice(),
+			val.IsTimeSlice(), val.IsDurationSlice():
 
 			sliceKey := k
 			if key != "" {
@@ -3,6 +3,7 @@ package objx_test
 import (
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/objx"
 )
@@ -113,6 +114,53 @@ func TestConversionURLValues(t *testing.T) {
 	}, u)
 }
 
+func TestConversionTimes(t *testing.T) {
+	created := time.Date(2024, 1, 2, 15, 4, 5, 500, time.UTC)
+	m := objx.Map{
+		"created":  created,
+		"timeout":  90 * time.Second,
+		"retries":  []time.Duration{time.Second, time.Minute},
+		"history":  []time.Time{created},
+		"settings": objx.Map{"ttl": time.Hour},
+	}
+
+	result, err := m.JSON()
+	require.NoError(t, err)
+	assert.Equal(t, `{"created":"2024-01-02T15:04:05.0000005Z","history":["2024-01-02T15:04:05.0000005Z"],"retries":["1s","1m0s"],"settings":{"ttl":"1h0m0s"},"timeout":"1m30s"}`, result)
+	assert.Equal(t, 90*time.Second, m["timeout"])
+
+	decoded := objx.MustFromJSON(result)
+	assert.True(t, created.Equal(decoded.Get("created").Time()))
+	assert.Equal(t, 90*time.Second, decoded.Get("timeout").Duration())
+	assert.Equal(t, []time.Duration{time.Second, time.Minute}, decoded.Get("retries").DurationSlice())
+
+	assert.Equal(t, url.Values{
+		"created":       []string{"2024-01-02T15:04:05.0000005Z"},
+		"timeout":       []string{"1m30s"},
+		"retries[]":     []string{"1s", "1m0s"},
+		"history[]":     []string{"2024-01-02T15:04:05.0000005Z"},
+		"settings[ttl]": []string{"1h0m0s"},
+	}, m.URLValues())
+	assert.Equal(t, "1m30s", m.Get("timeout").String())
+
+	nested := objx.Map{}
+	nested.Set("http.timeout", 2*time.Second)
+	nested.Set("http.retries", []interface{}{time.Second, []time.Duration{time.Minute}})
+	result, err = nested.JSON()
+	require.NoError(t, err)
+	assert.Equal(t, `{"http":{"retries":["1s",["1m0s"]],"timeout":"2s"}}`, result)
+	assert.Equal(t, 2*time.Second, nested.Get("http.timeout").Data())
+	decoded = objx.MustFromJSON(result)
+	assert.Equal(t, 2*time.Second, decoded.Get("http.timeout").Duration())
+	assert.Equal(t, time.Second, decoded.Get("http.retries[0]").Duration())
+	assert.Equal(t, []time.Duration{time.Minute}, decoded.Get("http.retries[1]").DurationSlice())
+
+	var nilMap objx.Map
+	result, err = nilMap.JSON()
+	assert.NoError(t, err)
+	assert.Equal(t, "null", result)
+}
+
 func TestConversionURLQuery(t *testing.T) {
 	m := getURLQueryMap()
 	u, err := m.URLQuery()
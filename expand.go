@@ -0,0 +1,120 @@
+package objx
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// placeholderPattern matches the content of a placeholder: a selector
+// optionally followed by a quoted default value.
+var placeholderPattern = regexp.MustCompile(`^\s*(.*?)\s*(?:\|\s*default\s*:\s*("(?:[^"\\]|\\.)*"|'(?:[^'\\]|\\.)*'))?\s*$`)
+
+// ExpandError lists the placeholders ExpandStrict could not resolve.
+type ExpandError struct {
+	// Unresolved are the selectors of the placeholders that match nothing
+	// and have no default, in order of appearance
+	Unresolved []string
+}
+
+// Error returns a description of the unresolved placeholders.
+func (e *ExpandError) Error() string {
+	return fmt.Sprintf("objx: unresolved placeholders: %s", strings.Join(e.Unresolved, ", "))
+}
+
+// Expand replaces every `{{selector}}` placeholder in the template with
+// the string form of the value at the selector, as returned by
+// Value.String:
+//
+//	m.Expand("Hello {{user.name}}, you have {{inbox.count}} messages")
+//
+// A placeholder may have a default value, used when the value is missing
+// or null:
+//
+//	m.Expand(`Hello {{user.name|default:"stranger"}}`)
+//
+// A backslash before `{{` keeps it as is (e.g. `\{{not a placeholder}}`).
+// Placeholders that cannot be resolved are replaced with an empty string
+// and an unterminated `{{` is kept as is. Use ExpandStrict to report them.
+func (m Map) Expand(template string) string {
+	s, _ := expand(template, m.Get)
+	return s
+}
+
+// ExpandStrict replaces every placeholder in the template like Expand.
+//
+// Returns an *ExpandError listing the placeholders that cannot be
+// resolved, or an error if a placeholder is unterminated.
+func (m Map) ExpandStrict(template string) (string, error) {
+	return expand(template, m.Get)
+}
+
+// Expand replaces every placeholder in the template like Map.Expand,
+// using the options of the View to resolve them.
+func (v View) Expand(template string) string {
+	s, _ := expand(template, v.Get)
+	return s
+}
+
+// ExpandStrict replaces every placeholder in the template like
+// Map.ExpandStrict, using the options of the View to resolve them.
+func (v View) ExpandStrict(template string) (string, error) {
+	return expand(template, v.Get)
+}
+
+// expand replaces the placeholders of the template with the values
+// returned by get.
+func expand(template string, get func(selector string) *Value) (string, error) {
+	var b strings.Builder
+	var unresolved []string
+	for {
+		start := strings.Index(template, "{{")
+		if start < 0 {
+			b.WriteString(template)
+			break
+		}
+		if start > 0 && template[start-1] == '\\' {
+			b.WriteString(template[:start-1] + "{{")
+			template = template[start+2:]
+			continue
+		}
+		end := strings.Index(template[start+2:], "}}")
+		if end < 0 {
+			b.WriteString(template)
+			return b.String(), fmt.Errorf("objx: unterminated placeholder %q", template[start:])
+		}
+
+		b.WriteString(template[:start])
+		content := template[start+2 : start+2+end]
+		template = template[start+2+end+2:]
+
+		match := placeholderPattern.FindStringSubmatch(content)
+		selector := match[1]
+		if v := get(selector); !v.IsNil() {
+			b.WriteString(v.String())
+		} else if match[2] != "" {
+			b.WriteString(unquoteDefault(match[2]))
+		} else {
+			unresolved = append(unresolved, selector)
+		}
+	}
+
+	if len(unresolved) > 0 {
+		return b.String(), &ExpandError{Unresolved: unresolved}
+	}
+	return b.String(), nil
+}
+
+// unquoteDefault removes the quotes around a default value and resolves
+// its backslash escapes.
+func unquoteDefault(quoted string) string {
+	var b strings.Builder
+	quoted = quoted[1 : len(quoted)-1]
+	for i := 0; i < len(quoted); i++ {
+		if quoted[i] == '\\' {
+			i++
+		}
+		b.WriteByte(quoted[i])
+	}
+	return b.String()
+}
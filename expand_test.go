@@ -0,0 +1,53 @@
+package objx_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/objx"
+)
+
+func TestExpand(t *testing.T) {
+	m := objx.Map{
+		"user":  objx.Map{"name": "Mat", "nickname": nil},
+		"inbox": objx.Map{"count": 3},
+		"tags":  []interface{}{"go", "objx"},
+	}
+
+	assert.Equal(t, "Hello Mat, you have 3 messages", m.Expand("Hello {{user.name}}, you have {{inbox.count}} messages"))
+	assert.Equal(t, "Mat objx", m.Expand("{{ user.name }} {{tags[-1]}}"))
+	assert.Equal(t, "Hello stranger", m.Expand(`Hello {{user.nickname|default:"stranger"}}`))
+	assert.Equal(t, "Hello Mat", m.Expand(`Hello {{user.name | default: 'stranger'}}`))
+	assert.Equal(t, `a "quoted" | b`, m.Expand(`{{nope|default:"a \"quoted\" | b"}}`))
+	assert.Equal(t, "Hello , bye", m.Expand("Hello {{user.missing}}, bye"))
+	assert.Equal(t, "{{user.name}} is Mat", m.Expand(`\{{user.name}} is {{user.name}}`))
+	assert.Equal(t, "Hello {{user.name", m.Expand("Hello {{user.name"))
+	assert.Equal(t, "no placeholders", m.Expand("no placeholders"))
+}
+
+func TestExpandStrict(t *testing.T) {
+	m := objx.Map{"user": objx.Map{"name": "Mat", "nickname": nil}}
+
+	s, err := m.ExpandStrict(`{{user.name}} {{user.nickname|default:"-"}}`)
+	require.NoError(t, err)
+	assert.Equal(t, "Mat -", s)
+
+	s, err = m.ExpandStrict("{{user.name}}{{user.email}} {{user.nickname}} {{a[}}")
+	assert.Equal(t, "Mat  ", s)
+	var expandErr *objx.ExpandError
+	require.True(t, errors.As(err, &expandErr))
+	assert.Equal(t, []string{"user.email", "user.nickname", "a["}, expandErr.Unresolved)
+	assert.Equal(t, "objx: unresolved placeholders: user.email, user.nickname, a[", err.Error())
+
+	_, err = m.ExpandStrict("Hello {{user.name")
+	assert.Error(t, err)
+}
+
+func TestViewExpand(t *testing.T) {
+	m := objx.Map{"User": objx.Map{"Name": "Mat"}}
+	v := m.WithOptions(objx.CaseInsensitive(), objx.Separator("/"))
+
+	assert.Equal(t, "Hello Mat", v.Expand("Hello {{user/name}}"))
+	_, err := v.ExpandStrict("{{user.name}}")
+	assert.Error(t, err)
+}
@@ -0,0 +1,428 @@
+package objx
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// filterExpr is a node of a compiled filter predicate such as
+// `@.price < 10 && @.inStock`.
+type filterExpr interface {
+	// eval evaluates the expression against the current element and
+	// returns its value and whether it exists.
+	eval(current interface{}) (interface{}, bool)
+	// format returns the canonical form of the expression, using sep to
+	// separate the keys of paths.
+	format(sep string) string
+}
+
+// filterLiteral is a string, number, boolean or null literal.
+type filterLiteral struct {
+	value interface{}
+}
+
+func (e filterLiteral) eval(interface{}) (interface{}, bool) {
+	return e.value, true
+}
+
+func (e filterLiteral) format(string) string {
+	switch v := e.value.(type) {
+	case string:
+		return quoteKey(v)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return "null"
+}
+
+// filterPath is a path relative to the current element, e.g. `@.a.b[0]`.
+type filterPath struct {
+	segments []segment
+}
+
+func (e filterPath) eval(current interface{}) (interface{}, bool) {
+	if len(e.segments) == 0 {
+		return current, true
+	}
+	a := accessor{}
+	a.access(current, e.segments)
+	return a.result, a.found
+}
+
+func (e filterPath) format(sep string) string {
+	var b strings.Builder
+	b.WriteByte('@')
+	for _, seg := range e.segments {
+		switch {
+		case seg.kind == segmentIndex:
+			b.WriteString("[" + strconv.Itoa(seg.index) + "]")
+		case seg.key != "" && !strings.ContainsAny(seg.key, sep+" \t\r\n[]()=!<>&|'\"\\"):
+			b.WriteString(sep + seg.key)
+		default:
+			b.WriteString("[" + quoteKey(seg.key) + "]")
+		}
+	}
+	return b.String()
+}
+
+// filterNot negates its operand.
+type filterNot struct {
+	operand filterExpr
+}
+
+func (e filterNot) eval(current interface{}) (interface{}, bool) {
+	return !truthy(e.operand.eval(current)), true
+}
+
+func (e filterNot) format(sep string) string {
+	return "!" + formatOperand(e.operand, sep)
+}
+
+// filterLogical is a `&&` or `||` expression.
+type filterLogical struct {
+	op          string
+	left, right filterExpr
+}
+
+func (e filterLogical) eval(current interface{}) (interface{}, bool) {
+	left := truthy(e.left.eval(current))
+	if e.op == "&&" {
+		return left && truthy(e.right.eval(current)), true
+	}
+	return left || truthy(e.right.eval(current)), true
+}
+
+func (e filterLogical) format(sep string) string {
+	return e.formatChild(e.left, sep) + " " + e.op + " " + e.formatChild(e.right, sep)
+}
+
+// formatChild formats an operand, enclosing it in parentheses if it is
+// an `||` expression inside an `&&` one.
+func (e filterLogical) formatChild(child filterExpr, sep string) string {
+	if l, ok := child.(filterLogical); ok && l.op != e.op {
+		return "(" + child.format(sep) + ")"
+	}
+	return child.format(sep)
+}
+
+// filterComparison is a comparison between two operands.
+type filterComparison struct {
+	op          string
+	left, right filterExpr
+}
+
+func (e filterComparison) eval(current interface{}) (interface{}, bool) {
+	left, leftOK := e.left.eval(current)
+	right, rightOK := e.right.eval(current)
+	return compareValues(e.op, left, leftOK, right, rightOK), true
+}
+
+func (e filterComparison) format(sep string) string {
+	return formatOperand(e.left, sep) + " " + e.op + " " + formatOperand(e.right, sep)
+}
+
+// formatOperand formats an operand of a comparison or a negation,
+// enclosing it in parentheses unless it is a path or a literal.
+func formatOperand(e filterExpr, sep string) string {
+	switch e.(type) {
+	case filterPath, filterLiteral:
+		return e.format(sep)
+	}
+	return "(" + e.format(sep) + ")"
+}
+
+// truthy returns whether a value selects an element. Missing values,
+// nil and false are falsy, everything else is truthy.
+func truthy(v interface{}, ok bool) bool {
+	if !ok || v == nil {
+		return false
+	}
+	if b, isBool := v.(bool); isBool {
+		return b
+	}
+	return true
+}
+
+// compareValues compares two filter operands. Numbers of any type are
+// compared by value and strings lexically. Missing values are only equal
+// to each other.
+func compareValues(op string, left interface{}, leftOK bool, right interface{}, rightOK bool) bool {
+	if !leftOK || !rightOK {
+		switch op {
+		case "==":
+			return !leftOK && !rightOK
+		case "!=":
+			return leftOK || rightOK
+		}
+		return false
+	}
+
+	var cmp int
+	lf, lnum := toFloat64(left)
+	rf, rnum := toFloat64(right)
+	ls, lstr := left.(string)
+	rs, rstr := right.(string)
+	switch {
+	case lnum && rnum:
+		cmp = compareOrdered(lf, rf)
+	case lstr && rstr:
+		cmp = strings.Compare(ls, rs)
+	default:
+		equal := valuesEqual(left, right)
+		switch op {
+		case "==", "<=", ">=":
+			return equal
+		case "!=":
+			return !equal
+		}
+		return false
+	}
+
+	switch op {
+	case "==":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	}
+	return cmp >= 0
+}
+
+// compareOrdered returns -1, 0 or 1 depending on whether a is less than,
+// equal to or greater than b.
+func compareOrdered(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// valuesEqual returns whether two values are deeply equal, treating Map
+// and map[string]interface{} alike.
+func valuesEqual(a, b interface{}) bool {
+	if m, ok := a.(Map); ok {
+		a = map[string]interface{}(m)
+	}
+	if m, ok := b.(Map); ok {
+		b = map[string]interface{}(m)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// toFloat64 converts any numeric value, including a json.Number, to a
+// float64.
+func toFloat64(v interface{}) (float64, bool) {
+	if n, ok := v.(json.Number); ok {
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	}
+	return 0, false
+}
+
+// parseFilter parses a filter predicate starting right after the `?` of
+// a `[?(...)]` segment.
+func (p *selectorParser) parseFilter() (filterExpr, error) {
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	return expr, nil
+}
+
+func (p *selectorParser) parseOr() (filterExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.consume("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = filterLogical{op: "||", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *selectorParser) parseAnd() (filterExpr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.consume("&&") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = filterLogical{op: "&&", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *selectorParser) parseUnary() (filterExpr, error) {
+	if p.consume("!") {
+		if p.consume("=") {
+			return nil, p.errorf(p.pos-2, "unexpected \"!=\"")
+		}
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return filterNot{operand: operand}, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *selectorParser) parseComparison() (filterExpr, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if p.consume(op) {
+			right, err := p.parseOperand()
+			if err != nil {
+				return nil, err
+			}
+			return filterComparison{op: op, left: left, right: right}, nil
+		}
+	}
+	return left, nil
+}
+
+func (p *selectorParser) parseOperand() (filterExpr, error) {
+	p.skipSpaces()
+	if p.pos == len(p.selector) {
+		return nil, p.errorf(p.pos, "unexpected end of filter")
+	}
+
+	start := p.pos
+	switch c := p.selector[p.pos]; {
+	case c == '(':
+		p.pos++
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.consume(")") {
+			return nil, p.errorf(start, "unterminated '('")
+		}
+		return expr, nil
+	case c == '@':
+		p.pos++
+		return p.parseFilterPath()
+	case c == '"' || c == '\'':
+		s, err := p.parseQuoted()
+		if err != nil {
+			return nil, err
+		}
+		return filterLiteral{value: s}, nil
+	case c == '-' || (c >= '0' && c <= '9'):
+		p.pos++
+		for p.pos < len(p.selector) && strings.IndexByte("0123456789.eE+-", p.selector[p.pos]) >= 0 {
+			p.pos++
+		}
+		n, err := strconv.ParseFloat(p.selector[start:p.pos], 64)
+		if err != nil {
+			return nil, p.errorf(start, "invalid number %q", p.selector[start:p.pos])
+		}
+		return filterLiteral{value: n}, nil
+	}
+
+	for _, keyword := range []string{"true", "false", "null"} {
+		if strings.HasPrefix(p.selector[p.pos:], keyword) && !p.isFilterKeyChar(p.pos+len(keyword)) {
+			p.pos += len(keyword)
+			switch keyword {
+			case "true":
+				return filterLiteral{value: true}, nil
+			case "false":
+				return filterLiteral{value: false}, nil
+			}
+			return filterLiteral{value: nil}, nil
+		}
+	}
+	return nil, p.errorf(start, "unexpected %q", p.selector[start])
+}
+
+// parseFilterPath parses the segments of a path following `@`.
+func (p *selectorParser) parseFilterPath() (filterExpr, error) {
+	var segments []segment
+	for p.pos < len(p.selector) {
+		start := p.pos
+		switch c := p.selector[p.pos]; {
+		case c == '[':
+			seg, err := p.parseBracket()
+			if err != nil {
+				return nil, err
+			}
+			if seg.kind != segmentKey && seg.kind != segmentIndex {
+				return nil, p.errorf(start, "filter paths must select a single value")
+			}
+			segments = append(segments, seg)
+		case c == p.sep:
+			p.pos++
+			key, err := p.parseUntil(func(c byte) bool {
+				return !p.isFilterKeyChar(p.pos)
+			})
+			if err != nil {
+				return nil, err
+			}
+			if key == "" {
+				return nil, p.errorf(start, "empty key")
+			}
+			segments = append(segments, segment{kind: segmentKey, key: key})
+		default:
+			return filterPath{segments: segments}, nil
+		}
+	}
+	return filterPath{segments: segments}, nil
+}
+
+// isFilterKeyChar returns whether the character at pos can be part of
+// an unquoted key inside a filter.
+func (p *selectorParser) isFilterKeyChar(pos int) bool {
+	if pos >= len(p.selector) {
+		return false
+	}
+	c := p.selector[pos]
+	return c != p.sep && strings.IndexByte(" \t\r\n[]()=!<>&|'\"", c) < 0
+}
+
+// consume skips spaces and consumes token if it comes next.
+func (p *selectorParser) consume(token string) bool {
+	p.skipSpaces()
+	if strings.HasPrefix(p.selector[p.pos:], token) {
+		p.pos += len(token)
+		return true
+	}
+	return false
+}
+
+// skipSpaces skips any whitespace.
+func (p *selectorParser) skipSpaces() {
+	for p.pos < len(p.selector) && strings.IndexByte(" \t\r\n", p.selector[p.pos]) >= 0 {
+		p.pos++
+	}
+}
@@ -0,0 +1,87 @@
+package objx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/objx"
+)
+
+var filterMap = objx.MustFromJSON(`{
+	"books": [
+		{"title": "Go", "price": 8.95, "inStock": true, "author": {"name": "Alan"}, "tags": ["go"]},
+		{"title": "objx", "price": 12.99, "inStock": true, "author": {"name": "Mat"}},
+		{"title": "testify", "price": 8.99, "inStock": false, "isbn": null},
+		{"title": "JSON", "price": 22.99, "inStock": true, "isbn": "0-553-21311-3"}
+	],
+	"users": {
+		"tyler": {"age": 30, "admin": true},
+		"mat": {"age": 29}
+	}
+}`)
+
+func TestFilterComparison(t *testing.T) {
+	for selector, expected := range map[string][]interface{}{
+		`books[?(@.price < 10)].title`:                 {"Go", "testify"},
+		`books[?(@.price <= 8.99)].title`:              {"Go", "testify"},
+		`books[?(@.price > 12.99)].title`:              {"JSON"},
+		`books[?(@.price >= 12.99)].title`:             {"objx", "JSON"},
+		`books[?(@.title == "objx")].price`:            {12.99},
+		`books[?(@.title == 'objx')].price`:            {12.99},
+		`books[?(@.title != "objx")].title`:            {"Go", "testify", "JSON"},
+		`books[?(@.title < "J")].title`:                {"Go"},
+		`books[?(@.inStock == false)].title`:           {"testify"},
+		`books[?(@.isbn == null)].title`:               {"testify"},
+		`books[?(@.author.name == "Mat")].title`:       {"objx"},
+		`books[?(@["author"]["name"] == "Mat")].title`: {"objx"},
+		`books[?(@.tags[0] == "go")].title`:            {"Go"},
+		`books[?(@.missing == @.other)].title`:         {"Go", "objx", "testify", "JSON"},
+		`books[?(@.price == -1)].title`:                {},
+		`users[?(@.age >= 30)].admin`:                  {true},
+	} {
+		assert.Equal(t, expected, filterMap.Get(selector).Data(), selector)
+	}
+}
+
+func TestFilterLogical(t *testing.T) {
+	for selector, expected := range map[string][]interface{}{
+		`books[?(@.price < 10 && @.inStock)].title`:                         {"Go"},
+		`books[?(@.price < 10 || @.price > 20)].title`:                      {"Go", "testify", "JSON"},
+		`books[?(!@.inStock)].title`:                                        {"testify"},
+		`books[?(@.isbn)].title`:                                            {"JSON"},
+		`books[?(!(@.price < 10) && @.inStock)].title`:                      {"objx", "JSON"},
+		`books[?( @.price<10 && (@.inStock || @.title=="testify") )].title`: {"Go", "testify"},
+		`books[?@.inStock].title`:                                           {"Go", "objx", "JSON"},
+		`books[?(true)].title`:                                              {"Go", "objx", "testify", "JSON"},
+		`books[?(@.inStock)][?(@ == "Go")]`:                                 {"Go"},
+	} {
+		assert.Equal(t, expected, filterMap.Get(selector).Data(), selector)
+	}
+}
+
+func TestFilterSet(t *testing.T) {
+	m := objx.MustFromJSON(`{"books": [{"price": 8}, {"price": 12}], "tags": ["a", "b"]}`)
+
+	m.Set("books[?(@.price < 10)].cheap", true)
+	m.Set(`tags[?(@ == "b")]`, "c")
+
+	assert.Equal(t, []interface{}{true}, m.Get("books[*].cheap").Data())
+	assert.Equal(t, []interface{}{"a", "c"}, m.Get("tags").Data())
+}
+
+func TestFilterWithError(t *testing.T) {
+	for _, selector := range []string{
+		`books[?(@.price < )]`,
+		`books[?(@.price < 10]`,
+		`books[?(@.price < 10)`,
+		`books[?(@.price = 10)]`,
+		`books[?(@.title == "Go)]`,
+		`books[?(@[*] == 1)]`,
+		`books[?(@. == 1)]`,
+		`books[?(nope)]`,
+		`books[?()]`,
+		`books[?(1.2.3 == 1)]`,
+	} {
+		_, err := objx.Compile(selector)
+		assert.Error(t, err, selector)
+	}
+}
@@ -0,0 +1,93 @@
+package objx
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Flatten returns a new Map holding every leaf of the Map under its
+// selector, as returned by Paths:
+//
+//	objx.Map{"a": objx.Map{"b": []interface{}{objx.Map{"c": 1}}}}.Flatten()
+//	// objx.Map{"a.b[0].c": 1}
+//
+// Keys containing the separator or brackets are quoted, e.g.
+// `labels["app.kubernetes.io/name"]`, so that Unflatten can rebuild the
+// Map exactly. Empty maps and arrays are kept as leaves.
+func (m Map) Flatten() Map {
+	return flatten(m, PathSeparator)
+}
+
+// Flatten returns a new Map holding every leaf of the underlying Map
+// under its selector, like Map.Flatten, using the separator of the View.
+//
+// Returns nil if the options of the View are invalid.
+func (v View) Flatten() Map {
+	if v.err != nil {
+		return nil
+	}
+	return flatten(v.m, v.opts.sep)
+}
+
+// flatten returns the leaves of m under their selector using sep.
+func flatten(m Map, sep string) Map {
+	flat := Map{}
+	visitPaths(m, sep, false, func(selector string, v interface{}) {
+		flat[selector] = v
+	})
+	return flat
+}
+
+// Unflatten rebuilds a nested Map from a Map whose keys are selectors,
+// such as the one returned by Flatten. Maps and arrays are created as
+// needed, arrays being padded with nil until all their elements are set.
+//
+// Keys are set in ascending order and later keys overwrite what earlier
+// ones set, so `a.b` replaces a value set at `a`, which it sorts after.
+//
+// Returns an error if a key is not a selector of a single value, if it
+// holds an index that the keys cannot fill, i.e. not smaller than their
+// number, or if an option is invalid.
+func Unflatten(flat Map, options ...Option) (Map, error) {
+	o, err := newOptions(options)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(flat))
+	for key := range flat {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	m := Map{}
+	for _, key := range keys {
+		s, err := compileCached(key, o)
+		if err != nil {
+			return nil, err
+		}
+		if s.multi {
+			return nil, fmt.Errorf("objx: cannot unflatten %q: selector matches more than one value", key)
+		}
+		for _, seg := range s.segments {
+			if seg.kind == segmentIndex && seg.index >= len(flat) {
+				return nil, fmt.Errorf("objx: cannot unflatten %q: index %d is larger than the number of keys", key, seg.index)
+			}
+		}
+		s.SetWith(m, flat[key], PadSlices())
+	}
+	return m, nil
+}
+
+// MustUnflatten rebuilds a nested Map from a Map whose keys are
+// selectors, like Unflatten.
+//
+// Panics if a key is not a selector of a single value or an option is
+// invalid.
+func MustUnflatten(flat Map, options ...Option) Map {
+	m, err := Unflatten(flat, options...)
+	if err != nil {
+		panic("objx: MustUnflatten failed with error: " + err.Error())
+	}
+	return m
+}
@@ -0,0 +1,94 @@
+package objx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/objx"
+)
+
+func TestFlatten(t *testing.T) {
+	m := objx.Map{
+		"a": objx.Map{
+			"b": []interface{}{
+				objx.Map{"c": 1},
+				"d",
+			},
+		},
+		"labels": objx.Map{"app.kubernetes.io/name": "objx"},
+		"empty":  []interface{}{},
+		"null":   nil,
+	}
+
+	assert.Equal(t, objx.Map{
+		"a.b[0].c":                         1,
+		"a.b[1]":                           "d",
+		`labels["app.kubernetes.io/name"]`: "objx",
+		"empty":                            []interface{}{},
+		"null":                             nil,
+	}, m.Flatten())
+}
+
+func TestUnflatten(t *testing.T) {
+	m, err := objx.Unflatten(objx.Map{
+		"a.b[1]":     "d",
+		"a.b[0].c":   1,
+		"list[9]":    9,
+		`x["y.z"]`:   true,
+		"x.empty":    objx.Map{},
+		"x.nothing":  nil,
+		"list[2]":    2,
+		"matrix[0]":  []interface{}{},
+		"matrix[1]":  []interface{}{1},
+		"deep[0][1]": "e",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, m.Get("a.b[0].c").Data())
+	assert.Equal(t, "d", m.Get("a.b[1]").Data())
+	assert.Equal(t, 10, len(m.Get("list").MustInterSlice()))
+	assert.Equal(t, 2, m.Get("list[2]").Data())
+	assert.Equal(t, 9, m.Get("list[9]").Data())
+	assert.Equal(t, true, m.Get(`x["y.z"]`).Data())
+	assert.Equal(t, objx.Map{}, m.Get("x.empty").Data())
+	assert.True(t, m.Get("x.nothing").IsNull())
+	assert.Equal(t, []interface{}{[]interface{}{}, []interface{}{1}}, m.Get("matrix").Data())
+	assert.Equal(t, []interface{}{[]interface{}{nil, "e"}}, m.Get("deep").Data())
+
+	for _, flat := range []objx.Map{
+		{"a[*]": 1},
+		{"..a": 1},
+		{"a[": 1},
+		{"a[9223372036854775807]": 1},
+		{"a[100000000000]": 1},
+		{"a[0]": 1, "b[2]": 2},
+	} {
+		_, err := objx.Unflatten(flat)
+
+		assert.Error(t, err)
+	}
+	assert.Panics(t, func() {
+		objx.MustUnflatten(objx.Map{"a[": 1})
+	})
+}
+
+func TestFlattenRoundTrip(t *testing.T) {
+	m := objx.MustFromJSON(`{
+		"name": "Mat",
+		"books": [{"title": "Go", "tags": ["a", "b"]}, {"title": "objx", "authors": []}],
+		"labels": {"app.kubernetes.io/name": "objx", "a[0]": 1, "quote\"d": 2, "": 3},
+		"matrix": [[1, 2], [3]],
+		"settings": {},
+		"nickname": null
+	}`)
+
+	assert.Equal(t, m, objx.MustUnflatten(m.Flatten()))
+
+	v := m.WithOptions(objx.Separator("/"))
+	flat := v.Flatten()
+
+	assert.Equal(t, float64(1), flat["matrix[0][0]"])
+	assert.Equal(t, "Go", flat["books[0]/title"])
+	assert.Equal(t, "objx", flat[`labels["app.kubernetes.io/name"]`])
+	assert.Equal(t, m, objx.MustUnflatten(flat, objx.Separator("/")))
+	assert.Nil(t, m.WithOptions(objx.Separator("")).Flatten())
+}
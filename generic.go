@@ -0,0 +1,329 @@
+package objx
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// As gets the value as a T and whether it could be converted.
+//
+// The value converts when it is a T, when its type has the same
+// underlying type as T (e.g. a map[string]interface{} for a Map, or a
+// string for a named string type) or, for numeric types, when it is a
+// number or a json.Number that T represents exactly. A time.Duration or
+// a time.Time converts like AsDuration or AsTime does. Nulls and missing
+// values never convert.
+//
+//	objx.As[int](objx.MustFromJSON(`{"age": 30}`).Get("age")) // 30, true
+func As[T any](v *Value) (T, bool) {
+	return convert[T](v.data)
+}
+
+// GetAs gets the value at the selector as a T, like As.
+//
+//	m := objx.MustFromJSON(`{"user": {"name": "Mat"}}`)
+//	user, ok := objx.GetAs[objx.Map](m, "user")
+func GetAs[T any](m Map, selector string) (T, bool) {
+	return As[T](m.Get(selector))
+}
+
+// MustGetAs gets the value at the selector as a T, like As.
+//
+// Panics with a *ConversionError if the value is missing or cannot be
+// converted.
+func MustGetAs[T any](m Map, selector string) T {
+	return mustAs[T](m.Get(selector))
+}
+
+// GetOr gets the value at the selector as a T, like As, or returns def
+// if it is missing or cannot be converted.
+func GetOr[T any](m Map, selector string, def T) T {
+	if t, ok := GetAs[T](m, selector); ok {
+		return t
+	}
+	return def
+}
+
+// SliceOf gets the value as a []T, converting each element of a slice
+// or an array like As does. It returns false if the value is not a slice
+// or if any of its elements cannot be converted.
+//
+// JSON arrays are decoded as []interface{}, so this is how to get them
+// as a slice of a specific type.
+//
+//	m := objx.MustFromJSON(`{"users": [{"name": "Mat"}, {"name": "Tyler"}]}`)
+//	users, ok := objx.SliceOf[objx.Map](m.Get("users"))
+func SliceOf[T any](v *Value) ([]T, bool) {
+	s, err := sliceOf[T](v)
+	return s, err == nil
+}
+
+// sliceOf gets the value as a []T like SliceOf, or returns an
+// *ElementError for the first element that cannot be converted.
+func sliceOf[T any](v *Value) ([]T, error) {
+	if s, ok := v.data.([]T); ok {
+		return s, nil
+	}
+	return convertSlice(v, targetName[[]T](), func(element *Value) (T, error) {
+		t, ok := As[T](element)
+		if !ok {
+			return t, element.conversionError(targetName[T](), ErrTypeMismatch)
+		}
+		return t, nil
+	})
+}
+
+// convert converts data to a T as described by As.
+func convert[T any](data interface{}) (T, bool) {
+	if t, ok := data.(T); ok {
+		return t, true
+	}
+
+	var zero T
+	switch interface{}(zero).(type) {
+	case time.Duration:
+		d, err := (&Value{data: data}).AsDuration()
+		return interface{}(d).(T), err == nil
+	case time.Time:
+		t, err := (&Value{data: data}).AsTime()
+		return interface{}(t).(T), err == nil
+	}
+
+	rv := reflect.ValueOf(data)
+	if !rv.IsValid() {
+		return zero, false
+	}
+	target := reflect.TypeOf(&zero).Elem()
+	if converted, ok := convertNumber(data, target); ok {
+		return converted.Interface().(T), true
+	}
+	if rv.Kind() == target.Kind() && rv.Type().ConvertibleTo(target) {
+		return rv.Convert(target).Interface().(T), true
+	}
+	return zero, false
+}
+
+// convertNumber converts a number or a json.Number to the numeric type
+// target, as long as it fits. Floats are rounded to float32 if needed,
+// every other conversion must be exact.
+func convertNumber(data interface{}, target reflect.Type) (reflect.Value, bool) {
+	if _, ok := data.(string); ok {
+		return reflect.Value{}, false
+	}
+	v := &Value{data: data}
+	converted := reflect.New(target).Elem()
+	switch target.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := v.AsInt64()
+		if err != nil || converted.OverflowInt(i) {
+			return reflect.Value{}, false
+		}
+		converted.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := v.AsUint64()
+		if err != nil || converted.OverflowUint(u) {
+			return reflect.Value{}, false
+		}
+		converted.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := v.AsFloat64()
+		if err != nil || converted.OverflowFloat(f) {
+			return reflect.Value{}, false
+		}
+		converted.SetFloat(f)
+	default:
+		return reflect.Value{}, false
+	}
+	return converted, true
+}
+
+// mustAs gets the value as a T, like As.
+//
+// Panics with a *ConversionError if it cannot be converted.
+func mustAs[T any](v *Value) T {
+	t, ok := As[T](v)
+	if !ok {
+		target := targetName[T]()
+		if !v.IsPresent() {
+			panic(v.conversionError(target, ErrNotFound))
+		}
+		panic(v.conversionError(target, ErrTypeMismatch))
+	}
+	return t
+}
+
+// typedOr gets the value as a T, returns the optional default or the
+// zero T if it is not a T.
+//
+// Unlike As, typedOr does not convert the value, except for a
+// json.Number, which converts to the numeric types that can hold it.
+func typedOr[T any](v *Value, optionalDefault []T) T {
+	if t, ok := typed[T](v.data); ok {
+		return t
+	}
+	if len(optionalDefault) == 1 {
+		return optionalDefault[0]
+	}
+	var zero T
+	return zero
+}
+
+// mustTyped gets the value as a T, like typedOr.
+//
+// Panics with a *ConversionError if it is not a T.
+func mustTyped[T any](v *Value) T {
+	t, ok := typed[T](v.data)
+	if !ok {
+		target := targetName[T]()
+		if !v.IsPresent() {
+			panic(v.conversionError(target, ErrNotFound))
+		}
+		panic(v.conversionError(target, ErrTypeMismatch))
+	}
+	return t
+}
+
+// typed returns data if it is a T, or the json.Number it holds as the
+// string or numeric type T, if it fits. Floats are rounded to float32
+// like strconv.ParseFloat does.
+func typed[T any](data interface{}) (T, bool) {
+	if t, ok := data.(T); ok {
+		return t, true
+	}
+	var zero T
+	n, ok := data.(json.Number)
+	if !ok {
+		return zero, false
+	}
+
+	target := reflect.TypeOf(&zero).Elem()
+	converted := reflect.New(target).Elem()
+	switch target.Kind() {
+	case reflect.String:
+		converted.SetString(n.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(n.String(), 10, target.Bits())
+		if err != nil {
+			return zero, false
+		}
+		converted.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(n.String(), 10, target.Bits())
+		if err != nil {
+			return zero, false
+		}
+		converted.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(n.String(), target.Bits())
+		if err != nil {
+			return zero, false
+		}
+		converted.SetFloat(f)
+	default:
+		return zero, false
+	}
+	return converted.Interface().(T), true
+}
+
+// sliceOr gets the value as a []T, like SliceOf, or returns the
+// optional default or nil if it cannot be converted.
+func sliceOr[T any](v *Value, optionalDefault [][]T) []T {
+	if s, err := sliceOf[T](v); err == nil {
+		return s
+	}
+	if len(optionalDefault) == 1 {
+		return optionalDefault[0]
+	}
+	return nil
+}
+
+// mustSliceOf gets the value as a []T, like SliceOf.
+//
+// Panics with an *ElementError or a *ConversionError if it cannot be
+// converted.
+func mustSliceOf[T any](v *Value) []T {
+	s, err := sliceOf[T](v)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// targetName returns the name of T used in conversion errors.
+func targetName[T any]() string {
+	var zero T
+	return reflect.TypeOf(&zero).Elem().String()
+}
+
+// isType gets whether the object contained is a T.
+func isType[T any](v *Value) bool {
+	_, ok := v.data.(T)
+	return ok
+}
+
+// isTyped gets whether the object contained is a T, or a json.Number
+// that typed converts to a T.
+func isTyped[T any](v *Value) bool {
+	_, ok := typed[T](v.data)
+	return ok
+}
+
+// eachOf calls the callback for each item of the []T, stopping when it
+// returns false.
+//
+// Panics if the object cannot be converted to a []T.
+func eachOf[T any](v *Value, callback func(int, T) bool) *Value {
+	for index, val := range mustSliceOf[T](v) {
+		if !callback(index, val) {
+			break
+		}
+	}
+	return v
+}
+
+// whereOf returns the items of the []T for which the decider returns
+// false.
+func whereOf[T any](v *Value, decider func(int, T) bool) *Value {
+	var selected []T
+	for index, val := range mustSliceOf[T](v) {
+		if !decider(index, val) {
+			selected = append(selected, val)
+		}
+	}
+	return &Value{data: selected}
+}
+
+// groupOf groups the items of the []T into a map[string][]T keyed by
+// the return of the grouper.
+func groupOf[T any](v *Value, grouper func(int, T) string) *Value {
+	groups := make(map[string][]T)
+	for index, val := range mustSliceOf[T](v) {
+		group := grouper(index, val)
+		groups[group] = append(groups[group], val)
+	}
+	return &Value{data: groups}
+}
+
+// replaceOf returns a []T holding the return of the replacer for each
+// item of the []T.
+func replaceOf[T any](v *Value, replacer func(int, T) T) *Value {
+	arr := mustSliceOf[T](v)
+	replaced := make([]T, len(arr))
+	for index, val := range arr {
+		replaced[index] = replacer(index, val)
+	}
+	return &Value{data: replaced}
+}
+
+// collectOf returns a []interface{} holding the return of the collector
+// for each item of the []T.
+func collectOf[T any](v *Value, collector func(int, T) interface{}) *Value {
+	arr := mustSliceOf[T](v)
+	collected := make([]interface{}, len(arr))
+	for index, val := range arr {
+		collected[index] = collector(index, val)
+	}
+	return &Value{data: collected}
+}
@@ -0,0 +1,218 @@
+package objx_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/objx"
+)
+
+type testID string
+
+func TestGetAs(t *testing.T) {
+	m := objx.MustFromJSON(`{"user": {"name": "Mat", "age": 30}, "id": "abc", "ratio": 0.5, "big": 300, "null": null}`)
+	m["book"] = testBook{Title: "objx"}
+	m["author"] = &testAuthor{Name: "Mat"}
+	m["timeout"] = 5
+
+	user, ok := objx.GetAs[objx.Map](m, "user")
+	assert.True(t, ok)
+	assert.Equal(t, "Mat", user.Get("name").Str())
+
+	msi, ok := objx.GetAs[map[string]interface{}](m, "user")
+	assert.True(t, ok)
+	assert.Equal(t, 30.0, msi["age"])
+
+	age, ok := objx.GetAs[int](m, "user.age")
+	assert.True(t, ok)
+	assert.Equal(t, 30, age)
+
+	id, ok := objx.GetAs[testID](m, "id")
+	assert.True(t, ok)
+	assert.Equal(t, testID("abc"), id)
+
+	book, ok := objx.GetAs[testBook](m, "book")
+	assert.True(t, ok)
+	assert.Equal(t, "objx", book.Title)
+
+	author, ok := objx.GetAs[*testAuthor](m, "author")
+	assert.True(t, ok)
+	assert.Equal(t, "Mat", author.Name)
+
+	timeout, ok := objx.GetAs[time.Duration](m, "timeout")
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Second, timeout)
+
+	for _, selector := range []string{"ratio", "big", "id", "null", "missing"} {
+		i, ok := objx.GetAs[int8](m, selector)
+
+		assert.False(t, ok, selector)
+		assert.Equal(t, int8(0), i, selector)
+	}
+
+	_, ok = objx.GetAs[testBook](m, "author")
+	assert.False(t, ok)
+	_, ok = objx.GetAs[interface{}](m, "null")
+	assert.False(t, ok)
+}
+
+func TestGetAsJSONNumber(t *testing.T) {
+	m := objx.MustFromJSONWith(`{"id": 9007199254740993, "price": 0.25}`, objx.UseNumber())
+
+	id, ok := objx.GetAs[int64](m, "id")
+	assert.True(t, ok)
+	assert.Equal(t, int64(9007199254740993), id)
+
+	_, ok = objx.GetAs[float64](m, "id")
+	assert.False(t, ok)
+
+	price, ok := objx.GetAs[float32](m, "price")
+	assert.True(t, ok)
+	assert.Equal(t, float32(0.25), price)
+
+	s, ok := objx.GetAs[string](m, "id")
+	assert.True(t, ok)
+	assert.Equal(t, "9007199254740993", s)
+}
+
+func TestMustGetAs(t *testing.T) {
+	m := objx.Map{"name": "Mat"}
+
+	assert.Equal(t, "Mat", objx.MustGetAs[string](m, "name"))
+
+	for selector, expected := range map[string]error{"name": objx.ErrTypeMismatch, "missing": objx.ErrNotFound} {
+		func() {
+			defer func() {
+				err, ok := recover().(error)
+				require.True(t, ok, selector)
+				assert.True(t, errors.Is(err, expected), selector)
+			}()
+			objx.MustGetAs[int](m, selector)
+		}()
+	}
+}
+
+func TestGetOr(t *testing.T) {
+	m := objx.MustFromJSON(`{"port": 8080, "host": "localhost"}`)
+
+	assert.Equal(t, 8080, objx.GetOr(m, "port", 80))
+	assert.Equal(t, 80, objx.GetOr(m, "host", 80))
+	assert.Equal(t, uint16(443), objx.GetOr(m, "tls.port", uint16(443)))
+	assert.Equal(t, testID("localhost"), objx.GetOr(m, "host", testID("")))
+}
+
+func TestSliceOf(t *testing.T) {
+	m := objx.MustFromJSON(`{"users": [{"name": "Mat"}, {"name": "Tyler"}], "ids": [1, 2, 3], "mixed": [1, "two"], "name": "Mat"}`)
+	m["names"] = []string{"Mat", "Tyler"}
+
+	users, ok := objx.SliceOf[objx.Map](m.Get("users"))
+	require.True(t, ok)
+	require.Len(t, users, 2)
+	assert.Equal(t, "Tyler", users[1].Get("name").Str())
+
+	ids, ok := objx.SliceOf[int](m.Get("ids"))
+	assert.True(t, ok)
+	assert.Equal(t, []int{1, 2, 3}, ids)
+
+	names, ok := objx.SliceOf[testID](m.Get("names"))
+	assert.True(t, ok)
+	assert.Equal(t, []testID{"Mat", "Tyler"}, names)
+
+	for _, selector := range []string{"mixed", "name", "missing"} {
+		s, ok := objx.SliceOf[int](m.Get(selector))
+
+		assert.False(t, ok, selector)
+		assert.Nil(t, s, selector)
+	}
+}
+
+func TestGetAsTimes(t *testing.T) {
+	m := objx.MustFromJSON(`{"timeout": 30, "retry": "1m30s", "created": "2024-01-02T15:04:05Z", "updated": 1704207845, "delays": [1, "2s"], "name": "Mat"}`)
+
+	assert.Equal(t, 30*time.Second, m.Get("timeout").Duration())
+	timeout, ok := objx.GetAs[time.Duration](m, "timeout")
+	assert.True(t, ok)
+	assert.Equal(t, 30*time.Second, timeout)
+
+	retry, ok := objx.GetAs[time.Duration](m, "retry")
+	assert.True(t, ok)
+	assert.Equal(t, 90*time.Second, retry)
+	assert.Equal(t, 90*time.Second, objx.GetOr(m, "retry", time.Minute))
+	assert.Equal(t, time.Minute, objx.GetOr(m, "name", time.Minute))
+	assert.Equal(t, time.Minute, objx.GetOr(m, "missing", time.Minute))
+
+	created := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	at, ok := objx.GetAs[time.Time](m, "created")
+	assert.True(t, ok)
+	assert.True(t, created.Equal(at))
+	at, ok = objx.GetAs[time.Time](m, "updated")
+	assert.True(t, ok)
+	assert.True(t, created.Equal(at))
+	_, ok = objx.GetAs[time.Time](m, "name")
+	assert.False(t, ok)
+	assert.Equal(t, created, objx.MustGetAs[time.Time](m, "updated"))
+
+	delays, ok := objx.SliceOf[time.Duration](m.Get("delays"))
+	assert.True(t, ok)
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second}, delays)
+}
+
+func TestTypedAccessorsKeepStrictTypes(t *testing.T) {
+	m := objx.MustFromJSON(`{"age": 30, "ratio": 0.5, "name": "Mat"}`)
+	m["int64"] = int64(30)
+	m["number"] = json.Number("30")
+
+	assert.Equal(t, 30, m.Get("age").Int())
+	assert.Equal(t, 30, m.Get("age").MustInt())
+	assert.Equal(t, 7, m.Get("ratio").Int(7))
+	assert.Equal(t, int8(0), m.Get("age").Int8())
+	assert.Equal(t, int64(0), m.Get("age").Int64())
+	assert.Equal(t, uint8(0), m.Get("age").Uint8())
+	assert.Equal(t, float32(0), m.Get("ratio").Float32())
+	assert.Equal(t, float64(0), m.Get("int64").Float64())
+	assert.Equal(t, int(0), m.Get("int64").Int())
+	assert.Equal(t, "30", m.Get("number").Str())
+	assert.Equal(t, int8(30), m.Get("number").Int8())
+	assert.Equal(t, float32(30), m.Get("number").Float32())
+	assert.Panics(t, func() {
+		m.Get("age").MustUint()
+	})
+	assert.Panics(t, func() {
+		m.Get("ratio").MustFloat32()
+	})
+
+	age, ok := objx.GetAs[int8](m, "age")
+	assert.True(t, ok)
+	assert.Equal(t, int8(30), age)
+}
+
+func TestTypedSliceAccessorsConvertElements(t *testing.T) {
+	m := objx.MustFromJSON(`{"ids": [1, 2, 3], "names": ["Mat", "Tyler"], "mixed": [1, "two"], "ratios": [0.5, 1], "none": []}`)
+
+	assert.Equal(t, []int{1, 2, 3}, m.Get("ids").MustIntSlice())
+	assert.Equal(t, []uint8{1, 2, 3}, m.Get("ids").Uint8Slice())
+	assert.Equal(t, []float64{0.5, 1}, m.Get("ratios").Float64Slice())
+	assert.Equal(t, []string{"Mat", "Tyler"}, m.Get("names").StrSlice())
+	assert.Equal(t, []string{}, m.Get("none").StrSlice())
+	assert.Nil(t, m.Get("ratios").IntSlice())
+	assert.Equal(t, []int{7}, m.Get("mixed").IntSlice([]int{7}))
+	assert.False(t, m.Get("ids").IsIntSlice())
+
+	var sum int
+	m.Get("ids").EachInt(func(i, val int) bool {
+		sum += val
+		return true
+	})
+	assert.Equal(t, 6, sum)
+
+	defer func() {
+		var elementErr *objx.ElementError
+		err, _ := recover().(error)
+		require.True(t, errors.As(err, &elementErr))
+		assert.Equal(t, 1, elementErr.Index)
+		assert.True(t, errors.Is(err, objx.ErrTypeMismatch))
+	}()
+	m.Get("mixed").MustIntSlice()
+}
@@ -0,0 +1,1136 @@
+package objx
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// jsonPathMaxInt is the largest integer allowed in a JSONPath query
+// (I-JSON, RFC 7493).
+const jsonPathMaxInt = 1<<53 - 1
+
+// Query evaluates the RFC 9535 JSONPath query against the Map and
+// returns every node it selects, in order. The normalized path of each
+// node (e.g. `$['books'][0]['title']`) is available through Value.Path.
+//
+// Object members are visited in sorted key order.
+//
+// Returns an error if the query is not a valid JSONPath query.
+//
+// # Example
+//
+//	titles, err := m.Query("$.books[?@.price < 10].title")
+func (m Map) Query(jsonPath string) ([]*Value, error) {
+	return m.Value().Query(jsonPath)
+}
+
+// Query evaluates the RFC 9535 JSONPath query against the data
+// contained in the Value and returns every node it selects, in order.
+//
+// See Map.Query for details.
+func (v *Value) Query(jsonPath string) ([]*Value, error) {
+	q, err := parseJSONPath(jsonPath)
+	if err != nil {
+		return nil, err
+	}
+	nodes := q.eval(v.data, v.data)
+	values := make([]*Value, len(nodes))
+	for i, n := range nodes {
+		values[i] = &Value{data: n.value, path: n.path}
+	}
+	return values, nil
+}
+
+// jsonPathNode is a value selected by a JSONPath query along with its
+// normalized path.
+type jsonPathNode struct {
+	value interface{}
+	path  string
+}
+
+// jsonPathQuery is a compiled JSONPath query, either absolute (`$...`)
+// or relative to the current node of a filter (`@...`).
+type jsonPathQuery struct {
+	relative bool
+	segments []jsonPathSegment
+}
+
+// jsonPathSegment is a child (`[...]`) or descendant (`..[...]`) segment.
+type jsonPathSegment struct {
+	descendant bool
+	selectors  []jsonPathSelector
+}
+
+// jsonPathSelectorKind describes what a JSONPath selector selects.
+type jsonPathSelectorKind int
+
+const (
+	jsonPathName jsonPathSelectorKind = iota
+	jsonPathWildcard
+	jsonPathIndex
+	jsonPathSlice
+	jsonPathFilter
+)
+
+// jsonPathSelector is a single selector of a segment.
+type jsonPathSelector struct {
+	kind   jsonPathSelectorKind
+	name   string
+	index  int
+	slice  segment
+	filter jsonPathLogical
+}
+
+// eval returns the nodes selected by the query. root is the value `$`
+// refers to and current the value `@` refers to.
+func (q *jsonPathQuery) eval(root, current interface{}) []jsonPathNode {
+	nodes := []jsonPathNode{{value: root, path: "$"}}
+	if q.relative {
+		nodes[0] = jsonPathNode{value: current, path: "@"}
+	}
+	for _, seg := range q.segments {
+		var selected []jsonPathNode
+		for _, n := range nodes {
+			if seg.descendant {
+				selected = seg.descend(root, n, selected)
+			} else {
+				selected = seg.apply(root, n, selected)
+			}
+		}
+		nodes = selected
+	}
+	return nodes
+}
+
+// singular returns whether the query can select at most one node.
+func (q *jsonPathQuery) singular() bool {
+	for _, seg := range q.segments {
+		if seg.descendant || len(seg.selectors) != 1 {
+			return false
+		}
+		if kind := seg.selectors[0].kind; kind != jsonPathName && kind != jsonPathIndex {
+			return false
+		}
+	}
+	return true
+}
+
+// apply appends the nodes selected from n by the segment to out.
+func (seg jsonPathSegment) apply(root interface{}, n jsonPathNode, out []jsonPathNode) []jsonPathNode {
+	for _, sel := range seg.selectors {
+		out = sel.apply(root, n, out)
+	}
+	return out
+}
+
+// descend appends the nodes selected by the segment from n and all of
+// its descendants to out.
+func (seg jsonPathSegment) descend(root interface{}, n jsonPathNode, out []jsonPathNode) []jsonPathNode {
+	out = seg.apply(root, n, out)
+	for _, child := range jsonPathChildren(n) {
+		out = seg.descend(root, child, out)
+	}
+	return out
+}
+
+// apply appends the nodes selected from n by the selector to out.
+func (sel jsonPathSelector) apply(root interface{}, n jsonPathNode, out []jsonPathNode) []jsonPathNode {
+	switch sel.kind {
+	case jsonPathName:
+		if v, ok := getKey(n.value, sel.name); ok {
+			out = append(out, jsonPathNode{value: v, path: n.path + normalizedName(sel.name)})
+		}
+	case jsonPathWildcard:
+		out = append(out, jsonPathChildren(n)...)
+	case jsonPathIndex:
+		if !isSlice(n.value) {
+			break
+		}
+		index := sel.index
+		if index < 0 {
+			index += sliceLen(n.value)
+		}
+		if index < 0 {
+			break
+		}
+		if v, ok := getIndex(n.value, index); ok {
+			out = append(out, jsonPathNode{value: v, path: n.path + normalizedIndex(index)})
+		}
+	case jsonPathSlice:
+		if !isSlice(n.value) || sel.slice.step == 0 {
+			break
+		}
+		start, end := rangeBounds(sliceLen(n.value), sel.slice)
+		for i := start; (sel.slice.step > 0 && i < end) || (sel.slice.step < 0 && i > end); i += sel.slice.step {
+			v, _ := getIndex(n.value, i)
+			out = append(out, jsonPathNode{value: v, path: n.path + normalizedIndex(i)})
+		}
+	case jsonPathFilter:
+		for _, child := range jsonPathChildren(n) {
+			if sel.filter.test(root, child.value) {
+				out = append(out, child)
+			}
+		}
+	}
+	return out
+}
+
+// jsonPathChildren returns the elements of an array or the members of
+// an object, in sorted key order.
+func jsonPathChildren(n jsonPathNode) []jsonPathNode {
+	if keys, ok := keysOf(n.value); ok {
+		children := make([]jsonPathNode, 0, len(keys))
+		for _, key := range keys {
+			v, _ := getKey(n.value, key)
+			children = append(children, jsonPathNode{value: v, path: n.path + normalizedName(key)})
+		}
+		return children
+	}
+	if !isSlice(n.value) {
+		return nil
+	}
+	children := make([]jsonPathNode, sliceLen(n.value))
+	for i := range children {
+		v, _ := getIndex(n.value, i)
+		children[i] = jsonPathNode{value: v, path: n.path + normalizedIndex(i)}
+	}
+	return children
+}
+
+// normalizedName returns the normalized path element for a member name.
+func normalizedName(name string) string {
+	var b strings.Builder
+	b.WriteString("['")
+	for _, r := range name {
+		switch r {
+		case '\b':
+			b.WriteString(`\b`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\'':
+			b.WriteString(`\'`)
+		case '\\':
+			b.WriteString(`\\`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(&b, `\u%04x`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteString("']")
+	return b.String()
+}
+
+// normalizedIndex returns the normalized path element for an array index.
+func normalizedIndex(index int) string {
+	return "[" + strconv.Itoa(index) + "]"
+}
+
+/*
+	Filter expressions
+	------------------------------------------------
+*/
+
+// jsonPathType is the type of a filter expression, as defined by
+// RFC 9535 section 2.4.1.
+type jsonPathType int
+
+const (
+	jsonPathValueType jsonPathType = iota
+	jsonPathLogicalType
+	jsonPathNodesType
+)
+
+// jsonPathLogical is a filter expression evaluating to true or false.
+type jsonPathLogical interface {
+	test(root, current interface{}) bool
+}
+
+// jsonPathComparable is a filter expression evaluating to a single value,
+// or to nothing.
+type jsonPathComparable interface {
+	value(root, current interface{}) (interface{}, bool)
+}
+
+// jsonPathLiteral is a string, number, boolean or null literal.
+type jsonPathLiteral struct {
+	v interface{}
+}
+
+func (e jsonPathLiteral) value(root, current interface{}) (interface{}, bool) {
+	return e.v, true
+}
+
+// jsonPathExistence tests whether a query selects at least one node.
+type jsonPathExistence struct {
+	query *jsonPathQuery
+}
+
+func (e jsonPathExistence) test(root, current interface{}) bool {
+	return len(e.query.eval(root, current)) > 0
+}
+
+// jsonPathSingular is the value of a singular query.
+type jsonPathSingular struct {
+	query *jsonPathQuery
+}
+
+func (e jsonPathSingular) value(root, current interface{}) (interface{}, bool) {
+	nodes := e.query.eval(root, current)
+	if len(nodes) == 0 {
+		return nil, false
+	}
+	return nodes[0].value, true
+}
+
+// jsonPathNot negates a logical expression.
+type jsonPathNot struct {
+	operand jsonPathLogical
+}
+
+func (e jsonPathNot) test(root, current interface{}) bool {
+	return !e.operand.test(root, current)
+}
+
+// jsonPathAnd is a `&&` expression.
+type jsonPathAnd struct {
+	left, right jsonPathLogical
+}
+
+func (e jsonPathAnd) test(root, current interface{}) bool {
+	return e.left.test(root, current) && e.right.test(root, current)
+}
+
+// jsonPathOr is a `||` expression.
+type jsonPathOr struct {
+	left, right jsonPathLogical
+}
+
+func (e jsonPathOr) test(root, current interface{}) bool {
+	return e.left.test(root, current) || e.right.test(root, current)
+}
+
+// jsonPathComparison compares two comparables.
+type jsonPathComparison struct {
+	op          string
+	left, right jsonPathComparable
+}
+
+func (e jsonPathComparison) test(root, current interface{}) bool {
+	left, leftOK := e.left.value(root, current)
+	right, rightOK := e.right.value(root, current)
+	switch e.op {
+	case "==":
+		return jsonPathEqual(left, leftOK, right, rightOK)
+	case "!=":
+		return !jsonPathEqual(left, leftOK, right, rightOK)
+	case "<":
+		return jsonPathLess(left, leftOK, right, rightOK)
+	case ">":
+		return jsonPathLess(right, rightOK, left, leftOK)
+	case "<=":
+		return jsonPathLess(left, leftOK, right, rightOK) || jsonPathEqual(left, leftOK, right, rightOK)
+	}
+	return jsonPathLess(right, rightOK, left, leftOK) || jsonPathEqual(left, leftOK, right, rightOK)
+}
+
+// jsonPathEqual compares two values as defined by RFC 9535 section 2.3.5.2.2.
+func jsonPathEqual(a interface{}, aOK bool, b interface{}, bOK bool) bool {
+	if !aOK || !bOK {
+		return !aOK && !bOK
+	}
+	if af, ok := toFloat64(a); ok {
+		bf, ok := toFloat64(b)
+		return ok && af == bf
+	}
+	if aObj, ok := toMSI(a); ok {
+		bObj, ok := toMSI(b)
+		if !ok || len(aObj) != len(bObj) {
+			return false
+		}
+		for key, av := range aObj {
+			bv, ok := bObj[key]
+			if !ok || !jsonPathEqual(av, true, bv, true) {
+				return false
+			}
+		}
+		return true
+	}
+	if isSlice(a) {
+		if !isSlice(b) || sliceLen(a) != sliceLen(b) {
+			return false
+		}
+		for i, n := 0, sliceLen(a); i < n; i++ {
+			av, _ := getIndex(a, i)
+			bv, _ := getIndex(b, i)
+			if !jsonPathEqual(av, true, bv, true) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// jsonPathLess returns whether a is less than b. Only numbers and
+// strings can be ordered.
+func jsonPathLess(a interface{}, aOK bool, b interface{}, bOK bool) bool {
+	if !aOK || !bOK {
+		return false
+	}
+	if af, ok := toFloat64(a); ok {
+		bf, ok := toFloat64(b)
+		return ok && af < bf
+	}
+	if as, ok := a.(string); ok {
+		bs, ok := b.(string)
+		return ok && as < bs
+	}
+	return false
+}
+
+// jsonPathFunctions describes the parameters and result of the function
+// extensions of RFC 9535 section 2.4.
+var jsonPathFunctions = map[string]struct {
+	params []jsonPathType
+	result jsonPathType
+}{
+	"length": {[]jsonPathType{jsonPathValueType}, jsonPathValueType},
+	"count":  {[]jsonPathType{jsonPathNodesType}, jsonPathValueType},
+	"match":  {[]jsonPathType{jsonPathValueType, jsonPathValueType}, jsonPathLogicalType},
+	"search": {[]jsonPathType{jsonPathValueType, jsonPathValueType}, jsonPathLogicalType},
+	"value":  {[]jsonPathType{jsonPathNodesType}, jsonPathValueType},
+}
+
+// jsonPathFunction is a call to one of the jsonPathFunctions. Arguments
+// are either jsonPathComparable or *jsonPathQuery values depending on the
+// parameter type.
+type jsonPathFunction struct {
+	name   string
+	args   []interface{}
+	result jsonPathType
+	// re is the compiled pattern of match and search when it is a literal
+	re *regexp.Regexp
+}
+
+func (f *jsonPathFunction) value(root, current interface{}) (interface{}, bool) {
+	switch f.name {
+	case "length":
+		v, ok := f.args[0].(jsonPathComparable).value(root, current)
+		if !ok {
+			return nil, false
+		}
+		if s, isStr := v.(string); isStr {
+			return utf8.RuneCountInString(s), true
+		}
+		if keys, isObj := keysOf(v); isObj {
+			return len(keys), true
+		}
+		if isSlice(v) {
+			return sliceLen(v), true
+		}
+		return nil, false
+	case "count":
+		return len(f.args[0].(*jsonPathQuery).eval(root, current)), true
+	case "value":
+		nodes := f.args[0].(*jsonPathQuery).eval(root, current)
+		if len(nodes) != 1 {
+			return nil, false
+		}
+		return nodes[0].value, true
+	}
+	return nil, false
+}
+
+func (f *jsonPathFunction) test(root, current interface{}) bool {
+	v, ok := f.args[0].(jsonPathComparable).value(root, current)
+	s, isStr := v.(string)
+	if !ok || !isStr {
+		return false
+	}
+	re := f.re
+	if re == nil {
+		pattern, ok := f.args[1].(jsonPathComparable).value(root, current)
+		p, isStr := pattern.(string)
+		if !ok || !isStr {
+			return false
+		}
+		if re, ok = compileIRegexp(p, f.name == "match"); !ok {
+			return false
+		}
+	}
+	return re.MatchString(s)
+}
+
+// compileIRegexp compiles an RFC 9485 I-Regexp. If full is true, the
+// expression has to match the whole string.
+func compileIRegexp(pattern string, full bool) (*regexp.Regexp, bool) {
+	var b strings.Builder
+	inClass := false
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\' && i+1 < len(pattern):
+			b.WriteByte(c)
+			i++
+			b.WriteByte(pattern[i])
+		case inClass:
+			inClass = c != ']'
+			b.WriteByte(c)
+		case c == '[':
+			inClass = true
+			b.WriteByte(c)
+		case c == '.':
+			b.WriteString(`[^\n\r]`)
+		case c == '^' || c == '$':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	expr := b.String()
+	if full {
+		expr = `\A(?:` + expr + `)\z`
+	}
+	re, err := regexp.Compile(expr)
+	return re, err == nil
+}
+
+/*
+	Parser
+	------------------------------------------------
+*/
+
+// parseJSONPath parses an RFC 9535 JSONPath query.
+func parseJSONPath(jsonPath string) (*jsonPathQuery, error) {
+	p := &jsonPathParser{query: jsonPath}
+	if !p.consume("$") {
+		return nil, p.errorf(0, "query must start with '$'")
+	}
+	q, err := p.parseSegments(false)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.query) {
+		return nil, p.errorf(p.pos, "unexpected %q", p.query[p.pos])
+	}
+	return q, nil
+}
+
+// jsonPathParser holds the state of a JSONPath query being parsed.
+type jsonPathParser struct {
+	query string
+	pos   int
+}
+
+// parseSegments parses the segments following `$` or `@`.
+func (p *jsonPathParser) parseSegments(relative bool) (*jsonPathQuery, error) {
+	q := &jsonPathQuery{relative: relative}
+	for {
+		start := p.pos
+		p.skipBlanks()
+		if !p.peek('[') && !p.peek('.') {
+			p.pos = start
+			return q, nil
+		}
+		seg, err := p.parseSegment()
+		if err != nil {
+			return nil, err
+		}
+		q.segments = append(q.segments, seg)
+	}
+}
+
+// parseSegment parses a child or descendant segment.
+func (p *jsonPathParser) parseSegment() (jsonPathSegment, error) {
+	var seg jsonPathSegment
+	if p.consume("..") {
+		seg.descendant = true
+		if p.peek('[') {
+			return seg, p.parseBracketed(&seg)
+		}
+	} else if !p.consume(".") {
+		return seg, p.parseBracketed(&seg)
+	}
+
+	if p.consume("*") {
+		seg.selectors = []jsonPathSelector{{kind: jsonPathWildcard}}
+		return seg, nil
+	}
+	name, err := p.parseMemberName()
+	if err != nil {
+		return seg, err
+	}
+	seg.selectors = []jsonPathSelector{{kind: jsonPathName, name: name}}
+	return seg, nil
+}
+
+// parseMemberName parses a member-name-shorthand.
+func (p *jsonPathParser) parseMemberName() (string, error) {
+	start := p.pos
+	for p.pos < len(p.query) {
+		r, size := utf8.DecodeRuneInString(p.query[p.pos:])
+		if r == utf8.RuneError && size == 1 {
+			return "", p.errorf(p.pos, "invalid UTF-8")
+		}
+		isFirst := r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || r >= 0x80
+		if !isFirst && (p.pos == start || r < '0' || r > '9') {
+			break
+		}
+		p.pos += size
+	}
+	if p.pos == start {
+		return "", p.errorf(start, "expected member name")
+	}
+	return p.query[start:p.pos], nil
+}
+
+// parseBracketed parses a `[...]` list of selectors.
+func (p *jsonPathParser) parseBracketed(seg *jsonPathSegment) error {
+	start := p.pos
+	p.pos++
+	for {
+		p.skipBlanks()
+		sel, err := p.parseSelector()
+		if err != nil {
+			return err
+		}
+		seg.selectors = append(seg.selectors, sel)
+		p.skipBlanks()
+		if p.consume("]") {
+			return nil
+		}
+		if p.pos == len(p.query) {
+			return p.errorf(start, "unterminated '['")
+		}
+		if !p.consume(",") {
+			return p.errorf(p.pos, "unexpected %q", p.query[p.pos])
+		}
+	}
+}
+
+// parseSelector parses a single selector inside brackets.
+func (p *jsonPathParser) parseSelector() (jsonPathSelector, error) {
+	if p.pos == len(p.query) {
+		return jsonPathSelector{}, p.errorf(p.pos, "expected selector")
+	}
+
+	switch c := p.query[p.pos]; {
+	case c == '\'' || c == '"':
+		name, err := p.parseString()
+		return jsonPathSelector{kind: jsonPathName, name: name}, err
+	case c == '*':
+		p.pos++
+		return jsonPathSelector{kind: jsonPathWildcard}, nil
+	case c == '?':
+		p.pos++
+		p.skipBlanks()
+		expr, err := p.parseOr()
+		if err != nil {
+			return jsonPathSelector{}, err
+		}
+		filter, err := p.toLogical(expr)
+		return jsonPathSelector{kind: jsonPathFilter, filter: filter}, err
+	}
+
+	sel := jsonPathSelector{kind: jsonPathIndex}
+	var bounds []*int
+	for part := 0; part < 3; part++ {
+		var n *int
+		if p.peek('-') || (p.pos < len(p.query) && p.query[p.pos] >= '0' && p.query[p.pos] <= '9') {
+			i, err := p.parseInt()
+			if err != nil {
+				return sel, err
+			}
+			n = &i
+			p.skipBlanks()
+		}
+		bounds = append(bounds, n)
+		if part == 2 || !p.consume(":") {
+			break
+		}
+		sel.kind = jsonPathSlice
+		p.skipBlanks()
+	}
+
+	if sel.kind == jsonPathIndex {
+		if bounds[0] == nil {
+			return sel, p.errorf(p.pos, "expected selector")
+		}
+		sel.index = *bounds[0]
+		return sel, nil
+	}
+
+	sel.slice = segment{kind: segmentRange, start: bounds[0], step: 1}
+	if len(bounds) > 1 {
+		sel.slice.end = bounds[1]
+	}
+	if len(bounds) > 2 && bounds[2] != nil {
+		sel.slice.step = *bounds[2]
+	}
+	return sel, nil
+}
+
+// parseInt parses an integer without leading zeros within the I-JSON range.
+func (p *jsonPathParser) parseInt() (int, error) {
+	start := p.pos
+	p.consume("-")
+	digits := p.pos
+	for p.pos < len(p.query) && p.query[p.pos] >= '0' && p.query[p.pos] <= '9' {
+		p.pos++
+	}
+	s := p.query[start:p.pos]
+	switch {
+	case p.pos == digits:
+		return 0, p.errorf(start, "expected integer")
+	case p.query[digits] == '0' && (p.pos-digits > 1 || digits > start):
+		return 0, p.errorf(start, "invalid integer %q", s)
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || n > jsonPathMaxInt || n < -jsonPathMaxInt {
+		return 0, p.errorf(start, "integer %s out of range", s)
+	}
+	return int(n), nil
+}
+
+// parseString parses a single or double quoted string literal.
+func (p *jsonPathParser) parseString() (string, error) {
+	start := p.pos
+	quote := p.query[p.pos]
+	p.pos++
+	var b strings.Builder
+	for {
+		if p.pos == len(p.query) {
+			return "", p.errorf(start, "unterminated string")
+		}
+		c := p.query[p.pos]
+		switch {
+		case c == quote:
+			p.pos++
+			return b.String(), nil
+		case c < 0x20:
+			return "", p.errorf(p.pos, "unescaped control character")
+		case c == '\\':
+			r, err := p.parseEscape(quote)
+			if err != nil {
+				return "", err
+			}
+			b.WriteRune(r)
+		default:
+			r, size := utf8.DecodeRuneInString(p.query[p.pos:])
+			if r == utf8.RuneError && size == 1 {
+				return "", p.errorf(p.pos, "invalid UTF-8")
+			}
+			b.WriteRune(r)
+			p.pos += size
+		}
+	}
+}
+
+// parseEscape parses a backslash escape sequence inside a string literal.
+func (p *jsonPathParser) parseEscape(quote byte) (rune, error) {
+	start := p.pos
+	p.pos++
+	if p.pos == len(p.query) {
+		return 0, p.errorf(start, "unterminated escape")
+	}
+	c := p.query[p.pos]
+	p.pos++
+	switch c {
+	case 'b':
+		return '\b', nil
+	case 'f':
+		return '\f', nil
+	case 'n':
+		return '\n', nil
+	case 'r':
+		return '\r', nil
+	case 't':
+		return '\t', nil
+	case '/', '\\', quote:
+		return rune(c), nil
+	case 'u':
+		r, err := p.parseHex()
+		if err != nil {
+			return 0, err
+		}
+		switch {
+		case r >= 0xDC00 && r <= 0xDFFF:
+			return 0, p.errorf(start, "invalid surrogate")
+		case r >= 0xD800 && r <= 0xDBFF:
+			if !p.consume(`\u`) {
+				return 0, p.errorf(start, "invalid surrogate")
+			}
+			low, err := p.parseHex()
+			if err != nil {
+				return 0, err
+			}
+			if low < 0xDC00 || low > 0xDFFF {
+				return 0, p.errorf(start, "invalid surrogate")
+			}
+			return (r-0xD800)<<10 + (low - 0xDC00) + 0x10000, nil
+		}
+		return r, nil
+	}
+	return 0, p.errorf(start, "invalid escape")
+}
+
+// parseHex parses the four hex digits of a `\u` escape.
+func (p *jsonPathParser) parseHex() (rune, error) {
+	if p.pos+4 > len(p.query) {
+		return 0, p.errorf(p.pos, "invalid unicode escape")
+	}
+	n, err := strconv.ParseUint(p.query[p.pos:p.pos+4], 16, 32)
+	if err != nil {
+		return 0, p.errorf(p.pos, "invalid unicode escape")
+	}
+	p.pos += 4
+	return rune(n), nil
+}
+
+// parseOr parses a logical-or expression. Like all the expression parsers
+// it returns a jsonPathLiteral, *jsonPathQuery, *jsonPathFunction or
+// jsonPathLogical, leaving type checking to the caller.
+func (p *jsonPathParser) parseOr() (interface{}, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.consumeOp("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		l, err := p.toLogical(left)
+		if err != nil {
+			return nil, err
+		}
+		r, err := p.toLogical(right)
+		if err != nil {
+			return nil, err
+		}
+		left = jsonPathOr{left: l, right: r}
+	}
+	return left, nil
+}
+
+// parseAnd parses a logical-and expression.
+func (p *jsonPathParser) parseAnd() (interface{}, error) {
+	left, err := p.parseBasic()
+	if err != nil {
+		return nil, err
+	}
+	for p.consumeOp("&&") {
+		right, err := p.parseBasic()
+		if err != nil {
+			return nil, err
+		}
+		l, err := p.toLogical(left)
+		if err != nil {
+			return nil, err
+		}
+		r, err := p.toLogical(right)
+		if err != nil {
+			return nil, err
+		}
+		left = jsonPathAnd{left: l, right: r}
+	}
+	return left, nil
+}
+
+// parseBasic parses a parenthesized, comparison or test expression.
+func (p *jsonPathParser) parseBasic() (interface{}, error) {
+	if p.consume("!") {
+		p.skipBlanks()
+		var operand interface{}
+		var err error
+		if p.peek('(') {
+			operand, err = p.parseParen()
+		} else {
+			start := p.pos
+			operand, err = p.parsePrimary()
+			if _, ok := operand.(jsonPathLiteral); ok {
+				return nil, p.errorf(start, "literal is not a logical expression")
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		logical, err := p.toLogical(operand)
+		if err != nil {
+			return nil, err
+		}
+		return jsonPathNot{operand: logical}, nil
+	}
+	if p.peek('(') {
+		return p.parseParen()
+	}
+
+	left, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if !p.consumeOp(op) {
+			continue
+		}
+		right, err := p.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+		l, err := p.toComparable(left)
+		if err != nil {
+			return nil, err
+		}
+		r, err := p.toComparable(right)
+		if err != nil {
+			return nil, err
+		}
+		return jsonPathComparison{op: op, left: l, right: r}, nil
+	}
+	return left, nil
+}
+
+// parseParen parses a parenthesized logical expression.
+func (p *jsonPathParser) parseParen() (interface{}, error) {
+	start := p.pos
+	p.pos++
+	p.skipBlanks()
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	logical, err := p.toLogical(expr)
+	if err != nil {
+		return nil, err
+	}
+	p.skipBlanks()
+	if !p.consume(")") {
+		return nil, p.errorf(start, "unterminated '('")
+	}
+	return logical, nil
+}
+
+// parsePrimary parses a literal, a query or a function call.
+func (p *jsonPathParser) parsePrimary() (interface{}, error) {
+	if p.pos == len(p.query) {
+		return nil, p.errorf(p.pos, "unexpected end of query")
+	}
+
+	start := p.pos
+	switch c := p.query[p.pos]; {
+	case c == '@' || c == '$':
+		p.pos++
+		return p.parseSegments(c == '@')
+	case c == '\'' || c == '"':
+		s, err := p.parseString()
+		return jsonPathLiteral{v: s}, err
+	case c == '-' || (c >= '0' && c <= '9'):
+		return p.parseNumber()
+	case c >= 'a' && c <= 'z':
+		for p.pos < len(p.query) && (p.query[p.pos] == '_' ||
+			(p.query[p.pos] >= 'a' && p.query[p.pos] <= 'z') ||
+			(p.query[p.pos] >= '0' && p.query[p.pos] <= '9')) {
+			p.pos++
+		}
+		name := p.query[start:p.pos]
+		if p.peek('(') {
+			return p.parseFunction(name, start)
+		}
+		switch name {
+		case "true":
+			return jsonPathLiteral{v: true}, nil
+		case "false":
+			return jsonPathLiteral{v: false}, nil
+		case "null":
+			return jsonPathLiteral{v: nil}, nil
+		}
+		return nil, p.errorf(start, "unexpected %q", name)
+	}
+	return nil, p.errorf(start, "unexpected %q", p.query[start])
+}
+
+// parseNumber parses a JSON number literal.
+func (p *jsonPathParser) parseNumber() (interface{}, error) {
+	start := p.pos
+	p.consume("-")
+	digits := p.pos
+	for p.pos < len(p.query) && p.query[p.pos] >= '0' && p.query[p.pos] <= '9' {
+		p.pos++
+	}
+	if p.pos == digits || (p.query[digits] == '0' && p.pos-digits > 1) {
+		return nil, p.errorf(start, "invalid number")
+	}
+	if p.consume(".") {
+		fraction := p.pos
+		for p.pos < len(p.query) && p.query[p.pos] >= '0' && p.query[p.pos] <= '9' {
+			p.pos++
+		}
+		if p.pos == fraction {
+			return nil, p.errorf(start, "invalid number")
+		}
+	}
+	if p.consume("e") || p.consume("E") {
+		if !p.consume("+") {
+			p.consume("-")
+		}
+		exponent := p.pos
+		for p.pos < len(p.query) && p.query[p.pos] >= '0' && p.query[p.pos] <= '9' {
+			p.pos++
+		}
+		if p.pos == exponent {
+			return nil, p.errorf(start, "invalid number")
+		}
+	}
+	n, err := strconv.ParseFloat(p.query[start:p.pos], 64)
+	if err != nil {
+		return nil, p.errorf(start, "invalid number")
+	}
+	return jsonPathLiteral{v: n}, nil
+}
+
+// parseFunction parses the arguments of a function call and checks
+// they are well-typed.
+func (p *jsonPathParser) parseFunction(name string, start int) (interface{}, error) {
+	def, ok := jsonPathFunctions[name]
+	if !ok {
+		return nil, p.errorf(start, "unknown function %q", name)
+	}
+	p.pos++
+
+	var exprs []interface{}
+	p.skipBlanks()
+	for !p.peek(')') {
+		if len(exprs) > 0 {
+			if !p.consume(",") {
+				return nil, p.errorf(p.pos, "expected ',' or ')'")
+			}
+			p.skipBlanks()
+		}
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+		p.skipBlanks()
+		if p.pos == len(p.query) {
+			return nil, p.errorf(start, "unterminated function call")
+		}
+	}
+	p.pos++
+	if len(exprs) != len(def.params) {
+		return nil, p.errorf(start, "%s() takes %d arguments", name, len(def.params))
+	}
+
+	f := &jsonPathFunction{name: name, result: def.result}
+	for i, expr := range exprs {
+		switch def.params[i] {
+		case jsonPathValueType:
+			arg, err := p.toComparable(expr)
+			if err != nil {
+				return nil, err
+			}
+			f.args = append(f.args, arg)
+		case jsonPathNodesType:
+			q, ok := expr.(*jsonPathQuery)
+			if !ok {
+				return nil, p.errorf(start, "%s() requires a query argument", name)
+			}
+			f.args = append(f.args, q)
+		}
+	}
+	if pattern, ok := exprs[len(exprs)-1].(jsonPathLiteral); ok && def.result == jsonPathLogicalType {
+		if s, ok := pattern.v.(string); ok {
+			if f.re, ok = compileIRegexp(s, name == "match"); !ok {
+				f.re = regexp.MustCompile(`[^\s\S]`)
+			}
+		}
+	}
+	return f, nil
+}
+
+// toLogical converts a parsed expression to a logical expression.
+func (p *jsonPathParser) toLogical(expr interface{}) (jsonPathLogical, error) {
+	switch e := expr.(type) {
+	case *jsonPathQuery:
+		return jsonPathExistence{query: e}, nil
+	case *jsonPathFunction:
+		if e.result == jsonPathLogicalType {
+			return e, nil
+		}
+		return nil, p.errorf(p.pos, "%s() does not return a logical value", e.name)
+	case jsonPathLogical:
+		return e, nil
+	}
+	return nil, p.errorf(p.pos, "literal is not a logical expression")
+}
+
+// toComparable converts a parsed expression to a comparable.
+func (p *jsonPathParser) toComparable(expr interface{}) (jsonPathComparable, error) {
+	switch e := expr.(type) {
+	case jsonPathLiteral:
+		return e, nil
+	case *jsonPathQuery:
+		if !e.singular() {
+			return nil, p.errorf(p.pos, "query is not singular")
+		}
+		return jsonPathSingular{query: e}, nil
+	case *jsonPathFunction:
+		if e.result == jsonPathValueType {
+			return e, nil
+		}
+		return nil, p.errorf(p.pos, "%s() does not return a value", e.name)
+	}
+	return nil, p.errorf(p.pos, "logical expression is not comparable")
+}
+
+// consumeOp skips blanks and consumes op if it comes next, followed by
+// any blanks.
+func (p *jsonPathParser) consumeOp(op string) bool {
+	start := p.pos
+	p.skipBlanks()
+	if !p.consume(op) {
+		p.pos = start
+		return false
+	}
+	p.skipBlanks()
+	return true
+}
+
+// consume consumes token if it comes next.
+func (p *jsonPathParser) consume(token string) bool {
+	if strings.HasPrefix(p.query[p.pos:], token) {
+		p.pos += len(token)
+		return true
+	}
+	return false
+}
+
+// peek returns whether c comes next.
+func (p *jsonPathParser) peek(c byte) bool {
+	return p.pos < len(p.query) && p.query[p.pos] == c
+}
+
+// skipBlanks skips spaces, tabs and line breaks.
+func (p *jsonPathParser) skipBlanks() {
+	for p.pos < len(p.query) && strings.IndexByte(" \t\r\n", p.query[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+// errorf returns an error describing an invalid JSONPath query.
+func (p *jsonPathParser) errorf(offset int, format string, args ...interface{}) error {
+	return fmt.Errorf("objx: invalid JSONPath %q: %s at offset %d", p.query, fmt.Sprintf(format, args...), offset)
+}
@@ -0,0 +1,175 @@
+package objx_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/objx"
+)
+
+// jsonPathTestSuite is a file in the format of the JSONPath Compliance
+// Test Suite.
+type jsonPathTestSuite struct {
+	Tests []jsonPathTestCase `json:"tests"`
+}
+
+// jsonPathTestCase is a test case of a jsonPathTestSuite.
+type jsonPathTestCase struct {
+	Name            string          `json:"name"`
+	Selector        string          `json:"selector"`
+	Document        interface{}     `json:"document"`
+	Result          []interface{}   `json:"result"`
+	ResultPaths     []string        `json:"result_paths"`
+	Results         [][]interface{} `json:"results"`
+	ResultsPaths    [][]string      `json:"results_paths"`
+	InvalidSelector bool            `json:"invalid_selector"`
+}
+
+// ctsKnownFailures lists the cases of the upstream compliance suite that
+// are known to fail, by name, with the reason they fail.
+var ctsKnownFailures = map[string]string{}
+
+func TestQueryComplianceSuite(t *testing.T) {
+	data, err := os.ReadFile("testdata/jsonpath-compliance-test-suite/cts.json")
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Skip("the JSONPath Compliance Test Suite is not vendored, see testdata/jsonpath-compliance-test-suite/README.md")
+	}
+	require.NoError(t, err)
+
+	runJSONPathTestSuite(t, data, ctsKnownFailures)
+}
+
+func TestQueryCases(t *testing.T) {
+	data, err := os.ReadFile("testdata/jsonpath_cases.json")
+	require.NoError(t, err)
+
+	runJSONPathTestSuite(t, data, nil)
+}
+
+// runJSONPathTestSuite runs the cases of a jsonPathTestSuite, expecting
+// the ones in knownFailures to fail.
+func runJSONPathTestSuite(t *testing.T, data []byte, knownFailures map[string]string) {
+	var suite jsonPathTestSuite
+	require.NoError(t, json.Unmarshal(data, &suite))
+
+	failures := 0
+	for _, test := range suite.Tests {
+		failure := runJSONPathTestCase(test)
+		_, known := knownFailures[test.Name]
+		switch {
+		case failure != "" && !known:
+			failures++
+			t.Errorf("%s: %s", test.Name, failure)
+		case failure == "" && known:
+			t.Errorf("%s: passes but is listed as a known failure", test.Name)
+		}
+	}
+	t.Logf("%d cases, %d known failures, %d unexpected failures", len(suite.Tests), len(knownFailures), failures)
+}
+
+// runJSONPathTestCase runs a test case and returns why it failed, or an
+// empty string if it passed.
+func runJSONPathTestCase(test jsonPathTestCase) string {
+	document := objx.Map{"document": test.Document}.Get("document")
+	nodes, err := document.Query(test.Selector)
+
+	if test.InvalidSelector {
+		if err == nil {
+			return "expected an invalid selector error"
+		}
+		return ""
+	}
+	if err != nil {
+		return fmt.Sprintf("unexpected error: %v", err)
+	}
+
+	values := make([]interface{}, len(nodes))
+	paths := make([]string, len(nodes))
+	for i, node := range nodes {
+		values[i] = node.Data()
+		paths[i] = node.Path()
+	}
+
+	if test.Results == nil {
+		if !reflect.DeepEqual(test.Result, values) || (test.ResultPaths != nil && !reflect.DeepEqual(test.ResultPaths, paths)) {
+			return fmt.Sprintf("expected %#v %#v, got %#v %#v", test.Result, test.ResultPaths, values, paths)
+		}
+		return ""
+	}
+	for i, result := range test.Results {
+		if reflect.DeepEqual(result, values) && (test.ResultsPaths == nil || reflect.DeepEqual(test.ResultsPaths[i], paths)) {
+			return ""
+		}
+	}
+	return fmt.Sprintf("unexpected result %#v %#v", values, paths)
+}
+
+func TestQuery(t *testing.T) {
+	m := objx.MustFromJSON(`{"books": [{"title": "Go", "price": 8}, {"title": "objx", "price": 12}]}`)
+
+	nodes, err := m.Query("$.books[?@.price < 10].title")
+
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	assert.Equal(t, "Go", nodes[0].Str())
+	assert.Equal(t, "$['books'][0]['title']", nodes[0].Path())
+	assert.Equal(t, "", m.Get("books[0].title").Path())
+}
+
+func TestQueryTypedData(t *testing.T) {
+	m := objx.Map{
+		"books": []objx.Map{{"title": "Go"}, {"title": "objx"}},
+		"ids":   []int{3, 1, 2},
+	}
+
+	nodes, err := m.Query("$.books[-1].title")
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	assert.Equal(t, "objx", nodes[0].Data())
+
+	nodes, err = m.Query("$.ids[?@ > 1]")
+	require.NoError(t, err)
+	require.Len(t, nodes, 2)
+	assert.Equal(t, 3, nodes[0].Data())
+	assert.Equal(t, "$['ids'][2]", nodes[1].Path())
+
+	m = objx.Map{
+		"labels": map[string]string{"app": "objx"},
+		"book":   &testBook{Title: "objx", Tags: []string{"go"}},
+	}
+
+	nodes, err = m.Query("$.labels.app")
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	assert.Equal(t, "objx", nodes[0].Data())
+
+	nodes, err = m.Query("$.book[?length(@) == 1]")
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	assert.Equal(t, "$['book']['tags']", nodes[0].Path())
+}
+
+func TestQueryJSONNumbers(t *testing.T) {
+	m := objx.MustFromJSONWith(`{"books": [{"price": 8}, {"price": 12}]}`, objx.UseNumber())
+
+	nodes, err := m.Query("$.books[?@.price < 10]")
+
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	assert.Equal(t, "$['books'][0]", nodes[0].Path())
+	assert.Equal(t, []interface{}{json.Number("12")}, m.Get("books[?(@.price > 10)].price").Data())
+}
+
+func TestQueryWithError(t *testing.T) {
+	m := objx.Map{}
+
+	nodes, err := m.Query("books[0]")
+
+	assert.Error(t, err)
+	assert.Nil(t, nodes)
+}
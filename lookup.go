@@ -0,0 +1,127 @@
+package objx
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNotFound is wrapped by a PathError when a key or an index does
+	// not exist, and by a ConversionError when the value to convert is
+	// missing.
+	ErrNotFound = errors.New("objx: not found")
+	// ErrTypeMismatch is wrapped by a PathError when a key or an index is
+	// applied to a value that is not a map or an array, and by a
+	// ConversionError when a value cannot be converted to the requested
+	// type.
+	ErrTypeMismatch = errors.New("objx: type mismatch")
+)
+
+// PathError describes why a selector could not be looked up.
+type PathError struct {
+	// Selector is the selector being looked up
+	Selector string
+	// Segment is the part of the selector that failed, e.g. `[2]`
+	Segment string
+	// Offset is the position of Segment in Selector
+	Offset int
+	// Expected is the kind of container the segment applies to, either
+	// "map" or "array"
+	Expected string
+	// Actual is the type of the value the segment was applied to
+	Actual string
+	// Err is either ErrNotFound or ErrTypeMismatch
+	Err error
+}
+
+// Error returns a description of the failed lookup.
+func (e *PathError) Error() string {
+	var reason string
+	switch {
+	case e.Err == ErrTypeMismatch:
+		reason = fmt.Sprintf("expected %s, found %s", e.Expected, e.Actual)
+	case e.Expected == "map":
+		reason = "key not found"
+	default:
+		reason = "index out of range"
+	}
+	return fmt.Sprintf("objx: cannot look up %q: segment %q at offset %d: %s", e.Selector, e.Segment, e.Offset, reason)
+}
+
+// Unwrap returns the underlying ErrNotFound or ErrTypeMismatch.
+func (e *PathError) Unwrap() error {
+	return e.Err
+}
+
+// Lookup gets the value using the specified selector and returns it
+// inside a new Value object.
+//
+// Unlike Get, Lookup returns an error if the selector is malformed or the
+// value cannot be found, in which case the error is a *PathError naming
+// the first part of the selector that failed:
+//
+//	_, err := objx.Map{"name": "Mat"}.Lookup("name.first")
+//	// objx: cannot look up "name.first": segment "first" at offset 5: expected map, found string
+//
+// Selectors matching more than one value (e.g. `books[*].title`) never
+// fail to be found and return every match, like Get.
+func (m Map) Lookup(selector string) (*Value, error) {
+	s, err := compileCached(selector, defaultOptions)
+	if err != nil {
+		return nil, err
+	}
+	return s.Lookup(m)
+}
+
+// Lookup gets the value at the selector and returns it inside a new
+// Value object, or a *PathError if it cannot be found.
+func (s *Selector) Lookup(m Map) (*Value, error) {
+	if s.multi {
+		return s.Get(m), nil
+	}
+
+	var current interface{} = m
+	for _, seg := range s.segments {
+		a := accessor{normalize: s.normalize}
+		a.access(current, []segment{seg})
+		if !a.found {
+			return nil, s.pathError(seg, current)
+		}
+		current = a.result
+	}
+	return &Value{data: current}, nil
+}
+
+// pathError returns the error describing why seg could not be applied
+// to current.
+func (s *Selector) pathError(seg segment, current interface{}) *PathError {
+	err := &PathError{
+		Selector: s.raw,
+		Segment:  seg.raw,
+		Offset:   seg.offset,
+		Expected: "array",
+		Actual:   typeName(current),
+		Err:      ErrNotFound,
+	}
+	if seg.kind == segmentKey {
+		err.Expected = "map"
+	}
+	if err.Expected != err.Actual {
+		err.Err = ErrTypeMismatch
+	}
+	return err
+}
+
+// typeName returns the name of the type of v, calling maps, structs and
+// slices of any type "map" and "array".
+func typeName(v interface{}) string {
+	switch {
+	case v == nil:
+		return "null"
+	case isKeyed(v):
+		return "map"
+	case isSlice(v):
+		return "array"
+	}
+	return fmt.Sprintf("%T", v)
+}
@@ -0,0 +1,67 @@
+package objx_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/objx"
+)
+
+func TestLookup(t *testing.T) {
+	m := objx.Map{
+		"name": "Mat",
+		"books": []interface{}{
+			objx.Map{"title": "Go"},
+		},
+		"empty": nil,
+	}
+
+	v, err := m.Lookup("books[0].title")
+	require.NoError(t, err)
+	assert.Equal(t, "Go", v.Str())
+
+	v, err = m.Lookup("empty")
+	require.NoError(t, err)
+	assert.Nil(t, v.Data())
+
+	v, err = m.Lookup("books[*].missing")
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{}, v.Data())
+
+	_, err = m.Lookup("books[")
+	assert.Error(t, err)
+}
+
+func TestLookupWithPathError(t *testing.T) {
+	m := objx.Map{
+		"name": "Mat",
+		"books": []interface{}{
+			objx.Map{"title": "Go"},
+		},
+		"empty": nil,
+	}
+
+	for selector, expected := range map[string]objx.PathError{
+		"author":         {Segment: "author", Offset: 0, Expected: "map", Actual: "map", Err: objx.ErrNotFound},
+		"books[1].title": {Segment: "[1]", Offset: 5, Expected: "array", Actual: "array", Err: objx.ErrNotFound},
+		"books[0].year":  {Segment: "year", Offset: 9, Expected: "map", Actual: "map", Err: objx.ErrNotFound},
+		"name.first":     {Segment: "first", Offset: 5, Expected: "map", Actual: "string", Err: objx.ErrTypeMismatch},
+		"name[0]":        {Segment: "[0]", Offset: 4, Expected: "array", Actual: "string", Err: objx.ErrTypeMismatch},
+		"books.title":    {Segment: "title", Offset: 6, Expected: "map", Actual: "array", Err: objx.ErrTypeMismatch},
+		`empty["a.b"]`:   {Segment: `["a.b"]`, Offset: 5, Expected: "map", Actual: "null", Err: objx.ErrTypeMismatch},
+	} {
+		v, err := m.Lookup(selector)
+
+		assert.Nil(t, v, selector)
+		var pathErr *objx.PathError
+		require.True(t, errors.As(err, &pathErr), selector)
+		expected.Selector = selector
+		assert.Equal(t, expected, *pathErr, selector)
+		assert.True(t, errors.Is(err, expected.Err), selector)
+	}
+
+	_, err := m.Lookup("name.first")
+	assert.Equal(t, `objx: cannot look up "name.first": segment "first" at offset 5: expected map, found string`, err.Error())
+	_, err = m.Lookup("books[3]")
+	assert.Equal(t, `objx: cannot look up "books[3]": segment "[3]" at offset 5: index out of range`, err.Error())
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"io"
 	"io/ioutil"
 	"net/url"
 	"strings"
@@ -129,6 +130,86 @@ func FromJSONSlice(jsonString string) ([]Map, error) {
 	return slice, nil
 }
 
+// DecodeOption configures how FromJSONWith and FromJSONSliceWith decode
+// JSON.
+type DecodeOption func(*decodeOptions)
+
+// decodeOptions holds the options of a JSON decoding.
+type decodeOptions struct {
+	// useNumber is whether numbers are decoded as json.Number
+	useNumber bool
+}
+
+// UseNumber makes FromJSONWith and FromJSONSliceWith decode numbers as
+// json.Number instead of float64, so that integers above 2^53 keep their
+// precision. The typed accessors, String and JSON understand json.Number.
+func UseNumber() DecodeOption {
+	return func(o *decodeOptions) {
+		o.useNumber = true
+	}
+}
+
+// MustFromJSONWith creates a new Map containing the data specified in the
+// jsonString, decoded with the specified options.
+//
+// Panics if the JSON is invalid.
+func MustFromJSONWith(jsonString string, options ...DecodeOption) Map {
+	o, err := FromJSONWith(jsonString, options...)
+	if err != nil {
+		panic("objx: MustFromJSONWith failed with error: " + err.Error())
+	}
+	return o
+}
+
+// FromJSONWith creates a new Map containing the data specified in the
+// jsonString, decoded with the specified options.
+//
+//	m, err := objx.FromJSONWith(`{"id": 9007199254740993}`, objx.UseNumber())
+//	m.Get("id").Int64() // 9007199254740993
+//
+// Returns an error if the JSON is invalid.
+func FromJSONWith(jsonString string, options ...DecodeOption) (Map, error) {
+	var m Map
+	if err := decodeJSON(jsonString, &m, options); err != nil {
+		return Nil, err
+	}
+	return m, nil
+}
+
+// FromJSONSliceWith creates a new slice of Map containing the data
+// specified in the jsonString, decoded with the specified options. Works
+// with jsons with a top level array
+//
+// Returns an error if the JSON is invalid.
+func FromJSONSliceWith(jsonString string, options ...DecodeOption) ([]Map, error) {
+	var slice []Map
+	if err := decodeJSON(jsonString, &slice, options); err != nil {
+		return nil, err
+	}
+	return slice, nil
+}
+
+// decodeJSON decodes jsonString into target, rejecting anything but
+// whitespace after the top level value like json.Unmarshal does.
+func decodeJSON(jsonString string, target interface{}, options []DecodeOption) error {
+	o := decodeOptions{}
+	for _, option := range options {
+		option(&o)
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(jsonString))
+	if o.useNumber {
+		decoder.UseNumber()
+	}
+	if err := decoder.Decode(target); err != nil {
+		return err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return errors.New("objx: invalid data after top-level JSON value")
+	}
+	return nil
+}
+
 // FromBase64 creates a new Obj containing the data specified
 // in the Base64 string.
 //
@@ -1,6 +1,7 @@
 package objx_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/objx"
@@ -242,3 +243,73 @@ func TestJSONTopLevelSliceWithError(t *testing.T) {
 		_ = objx.MustFromJSONSlice(`{"id": 10000001}`)
 	})
 }
+
+func TestMapFromJSONWithUseNumber(t *testing.T) {
+	jsonString := `{"id": 9007199254740993, "price": 0.1, "neg": -3, "big": 1e400, "ids": [18446744073709551615]}`
+
+	m, err := objx.FromJSONWith(jsonString, objx.UseNumber())
+
+	require.NoError(t, err)
+	assert.Equal(t, json.Number("9007199254740993"), m.Get("id").Data())
+	assert.Equal(t, int64(9007199254740993), m.Get("id").Int64())
+	assert.Equal(t, int64(9007199254740993), m.Get("id").MustInt64())
+	assert.Equal(t, uint64(9007199254740993), m.Get("id").Uint64())
+	assert.Equal(t, int8(-3), m.Get("neg").Int8())
+	assert.Equal(t, uint(0), m.Get("neg").Uint())
+	assert.Equal(t, 0, m.Get("price").Int())
+	assert.Equal(t, 0.1, m.Get("price").Float64())
+	assert.Equal(t, float32(0.1), m.Get("price").MustFloat32())
+	assert.Equal(t, 7.0, m.Get("big").Float64(7))
+	assert.Equal(t, uint64(18446744073709551615), m.Get("ids[0]").Uint64())
+	assert.Equal(t, "9007199254740993", m.Get("id").String())
+	assert.Equal(t, "9007199254740993", m.Get("id").Str())
+	assert.Panics(t, func() {
+		m.Get("id").MustInt8()
+	})
+
+	assert.True(t, m.Get("id").IsInt64())
+	assert.True(t, m.Get("id").IsUint64())
+	assert.True(t, m.Get("id").IsStr())
+	assert.False(t, m.Get("id").IsInt8())
+	assert.False(t, m.Get("id").IsBool())
+	assert.True(t, m.Get("neg").IsInt8())
+	assert.False(t, m.Get("neg").IsUint())
+	assert.True(t, m.Get("price").IsFloat64())
+	assert.False(t, m.Get("price").IsInt())
+	assert.False(t, m.Get("big").IsFloat64())
+	assert.False(t, m.Get("id").IsDuration())
+	assert.False(t, m.Get("ids").IsUint64Slice())
+
+	result, err := m.JSON()
+	require.NoError(t, err)
+	assert.Equal(t, `{"big":1e400,"id":9007199254740993,"ids":[18446744073709551615],"neg":-3,"price":0.1}`, result)
+
+	m, err = objx.FromJSONWith(`{"id": 9007199254740993}`)
+	require.NoError(t, err)
+	assert.Equal(t, float64(9007199254740992), m.Get("id").Data())
+}
+
+func TestMapFromJSONWithOptionsError(t *testing.T) {
+	for _, jsonString := range []string{`"name":"Mat"}`, `{"name":"Mat"} {}`, `[]`} {
+		m, err := objx.FromJSONWith(jsonString, objx.UseNumber())
+
+		assert.Error(t, err, jsonString)
+		assert.Nil(t, m, jsonString)
+	}
+	assert.Panics(t, func() {
+		objx.MustFromJSONWith(`{"name":"Mat"} x`)
+	})
+}
+
+func TestJSONTopLevelSliceWith(t *testing.T) {
+	slice, err := objx.FromJSONSliceWith(`[{"id": 10000000000000001}, {"id": 42}]`, objx.UseNumber())
+
+	require.NoError(t, err)
+	require.Len(t, slice, 2)
+	assert.Equal(t, int64(10000000000000001), slice[0].Get("id").Int64())
+	assert.Equal(t, 42, slice[1].Get("id").MustInt())
+
+	slice, err = objx.FromJSONSliceWith(`{"id": 42}`, objx.UseNumber())
+	assert.Error(t, err)
+	assert.Nil(t, slice)
+}
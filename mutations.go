@@ -12,6 +12,52 @@ func (m Map) Exclude(exclude []string) Map {
 	return excluded
 }
 
+// ExcludeNulls returns a new Map without the keys holding an explicit
+// null, at any depth. Nested maps, including those inside arrays, are
+// copied rather than modified.
+//
+// It can be used before a conversion to leave nulls out, e.g. because
+// URLValues encodes them as empty values.
+func (m Map) ExcludeNulls() Map {
+	return excludeNulls(m).(Map)
+}
+
+// excludeNulls returns a copy of v without the keys holding an explicit
+// null in any of the maps it contains.
+func excludeNulls(v interface{}) interface{} {
+	switch v := v.(type) {
+	case Map:
+		excluded := make(Map, len(v))
+		for k, val := range v {
+			if val != nil {
+				excluded[k] = excludeNulls(val)
+			}
+		}
+		return excluded
+	case map[string]interface{}:
+		return map[string]interface{}(excludeNulls(Map(v)).(Map))
+	case []interface{}:
+		excluded := make([]interface{}, len(v))
+		for i, val := range v {
+			excluded[i] = excludeNulls(val)
+		}
+		return excluded
+	case []Map:
+		excluded := make([]Map, len(v))
+		for i, val := range v {
+			excluded[i] = excludeNulls(val).(Map)
+		}
+		return excluded
+	case []map[string]interface{}:
+		excluded := make([]map[string]interface{}, len(v))
+		for i, val := range v {
+			excluded[i] = excludeNulls(val).(map[string]interface{})
+		}
+		return excluded
+	}
+	return v
+}
+
 // Copy creates a shallow copy of the Obj.
 func (m Map) Copy() Map {
 	copied := Map{}
@@ -21,12 +67,41 @@ func (m Map) Copy() Map {
 	return copied
 }
 
+// MergeOption configures how Merge and MergeHere handle explicit nulls
+// in the specified map.
+type MergeOption func(*mergeOptions)
+
+// mergeOptions holds the options of a merge.
+type mergeOptions struct {
+	// skipNulls is whether nulls leave existing values untouched
+	skipNulls bool
+	// deleteNulls is whether nulls remove existing values
+	deleteNulls bool
+}
+
+// SkipNulls makes Merge and MergeHere ignore keys holding an explicit
+// null in the specified map, as if they were missing.
+func SkipNulls() MergeOption {
+	return func(o *mergeOptions) {
+		o.skipNulls = true
+	}
+}
+
+// DeleteNulls makes Merge and MergeHere remove the keys holding an
+// explicit null in the specified map, like a JSON Merge Patch (RFC 7396)
+// does. Missing keys are left untouched.
+func DeleteNulls() MergeOption {
+	return func(o *mergeOptions) {
+		o.deleteNulls = true
+	}
+}
+
 // Merge blends the specified map with a copy of this map and returns the result.
 //
 // Keys that appear in both will be selected from the specified map.
 // This method requires that the wrapped object be a map[string]interface{}
-func (m Map) Merge(merge Map) Map {
-	return m.Copy().MergeHere(merge)
+func (m Map) Merge(merge Map, options ...MergeOption) Map {
+	return m.Copy().MergeHere(merge, options...)
 }
 
 // MergeHere blends the specified map with this map and returns the current map.
@@ -34,9 +109,22 @@ func (m Map) Merge(merge Map) Map {
 // Keys that appear in both will be selected from the specified map. The original map
 // will be modified. This method requires that
 // the wrapped object be a map[string]interface{}
-func (m Map) MergeHere(merge Map) Map {
+//
+// By default an explicit null in the specified map replaces the value in
+// this map. SkipNulls and DeleteNulls change that.
+func (m Map) MergeHere(merge Map, options ...MergeOption) Map {
+	var o mergeOptions
+	for _, option := range options {
+		option(&o)
+	}
 	for k, v := range merge {
-		m[k] = v
+		switch {
+		case v == nil && o.deleteNulls:
+			delete(m, k)
+		case v == nil && o.skipNulls:
+		default:
+			m[k] = v
+		}
 	}
 	return m
 }
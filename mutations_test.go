@@ -21,6 +21,20 @@ func TestExclude(t *testing.T) {
 	assert.False(t, excluded.Has("secret"), "secret should be excluded")
 }
 
+func TestExcludeNulls(t *testing.T) {
+	m := objx.MustFromJSON(`{"name": "Mat", "nickname": null, "address": {"city": null, "state": "UT"}, "tags": [null, {"a": null}]}`)
+
+	excluded := m.ExcludeNulls()
+
+	assert.Equal(t, objx.Map{
+		"name":    "Mat",
+		"address": map[string]interface{}{"state": "UT"},
+		"tags":    []interface{}{nil, map[string]interface{}{}},
+	}, excluded)
+	assert.True(t, m.Exists("nickname"))
+	assert.True(t, m.Exists("address.city"))
+}
+
 func TestCopy(t *testing.T) {
 	m1 := objx.Map{
 		"name":     "Tyler",
@@ -69,6 +83,25 @@ func TestMergeHere(t *testing.T) {
 	assert.Equal(t, merged.Get("location").Str(), m1.Get("location").Str())
 }
 
+func TestMergeNulls(t *testing.T) {
+	m1 := objx.Map{
+		"name":     "Mat",
+		"location": "UT",
+	}
+	m2 := objx.Map{
+		"location": nil,
+		"nickname": nil,
+	}
+
+	assert.Equal(t, objx.Map{"name": "Mat", "location": nil, "nickname": nil}, m1.Merge(m2))
+	assert.Equal(t, objx.Map{"name": "Mat", "location": "UT"}, m1.Merge(m2, objx.SkipNulls()))
+	assert.Equal(t, objx.Map{"name": "Mat"}, m1.Merge(m2, objx.DeleteNulls()))
+	assert.Equal(t, objx.Map{"name": "Mat", "location": "UT"}, m1)
+
+	m1.MergeHere(m2, objx.DeleteNulls())
+	assert.Equal(t, objx.Map{"name": "Mat"}, m1)
+}
+
 func TestTransform(t *testing.T) {
 	m := objx.Map{
 		"name":     "Mat",
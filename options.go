@@ -0,0 +1,184 @@
+package objx
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Option configures how selectors are parsed.
+type Option func(*parseOptions)
+
+// parseOptions holds the settings used to parse selectors.
+type parseOptions struct {
+	// sep separates the keys of a selector
+	sep string
+	// normalize maps keys to the form they are compared in, if set
+	normalize func(key string) string
+}
+
+// defaultOptions are the settings used by the Map accessors.
+var defaultOptions = parseOptions{sep: PathSeparator}
+
+// newOptions returns the default settings changed by the options, or an
+// error if they are invalid.
+func newOptions(opts []Option) (parseOptions, error) {
+	o := defaultOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if len(o.sep) != 1 || strings.ContainsAny(o.sep, `[]\"'*@`) {
+		return parseOptions{}, fmt.Errorf("objx: invalid separator %q", o.sep)
+	}
+	return o, nil
+}
+
+// Separator makes selectors use sep instead of PathSeparator to separate
+// keys. The separator must be a single character other than a bracket,
+// a quote, a backslash, `*` or `@`.
+//
+// # Example
+//
+//	m.WithOptions(objx.Separator("/")).Get("hosts/example.com/port")
+func Separator(sep string) Option {
+	return func(o *parseOptions) {
+		o.sep = sep
+	}
+}
+
+// CaseInsensitive makes selectors match keys regardless of their case,
+// e.g. `username` matches `UserName`. It is a shorthand for
+// KeyNormalizer(strings.ToLower).
+func CaseInsensitive() Option {
+	return KeyNormalizer(strings.ToLower)
+}
+
+// KeyNormalizer makes selectors match the keys that normalize to the same
+// string as the keys they hold. Keys inside filters are still matched
+// exactly.
+//
+// A key that matches exactly always wins. Otherwise, when several keys
+// normalize to the same string, the first one in ascending order wins
+// (declaration order for struct fields). Setting a key that matches none
+// creates it as written in the selector.
+//
+// # Example
+//
+//	m := objx.Map{"user_name": "Mat"}
+//	m.WithOptions(objx.KeyNormalizer(objx.FoldKey)).Get("UserName") // "Mat"
+func KeyNormalizer(normalize func(key string) string) Option {
+	return func(o *parseOptions) {
+		o.normalize = normalize
+	}
+}
+
+// FoldKey is a key normalizer ignoring case and the underscores, dashes
+// and spaces separating words, so that `UserName`, `user_name`,
+// `user-name` and `username` are the same key.
+func FoldKey(key string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '_', '-', ' ':
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, key)
+}
+
+// View gives access to a Map using selectors parsed with a set of
+// options. It is created by Map.WithOptions.
+//
+// A View holds no state of its own besides the options, so it is as safe
+// for concurrent use as the underlying Map.
+type View struct {
+	m    Map
+	opts parseOptions
+	err  error
+}
+
+// WithOptions returns a View of the Map whose accessors parse selectors
+// using the options.
+//
+// If an option is invalid, every accessor of the View behaves as for a
+// malformed selector and Lookup returns the error.
+func (m Map) WithOptions(opts ...Option) View {
+	o, err := newOptions(opts)
+	return View{m: m, opts: o, err: err}
+}
+
+// Map returns the underlying Map.
+func (v View) Map() Map {
+	return v.m
+}
+
+// compile returns the compiled selector.
+func (v View) compile(selector string) (*Selector, error) {
+	if v.err != nil {
+		return nil, v.err
+	}
+	return compileCached(selector, v.opts)
+}
+
+// Get gets the value using the specified selector, like Map.Get.
+func (v View) Get(selector string) *Value {
+	s, err := v.compile(selector)
+	if err != nil {
+		return &Value{missing: true}
+	}
+	return s.Get(v.m)
+}
+
+// Set sets the value using the specified selector, like Map.Set, and
+// returns the underlying Map.
+func (v View) Set(selector string, value interface{}) Map {
+	return v.SetWith(selector, value)
+}
+
+// SetWith sets the value using the specified selector and set options,
+// like Map.SetWith, and returns the underlying Map.
+func (v View) SetWith(selector string, value interface{}, options ...SetOption) Map {
+	s, err := v.compile(selector)
+	if err != nil {
+		return v.m
+	}
+	return s.SetWith(v.m, value, options...)
+}
+
+// Delete removes the value using the specified selector, like Map.Delete.
+func (v View) Delete(selector string) (*Value, bool) {
+	s, err := v.compile(selector)
+	if err != nil {
+		return &Value{missing: true}, false
+	}
+	return s.Delete(v.m)
+}
+
+// Has gets whether there is something at the specified selector, like
+// Map.Has.
+func (v View) Has(selector string) bool {
+	s, err := v.compile(selector)
+	if err != nil || v.m == nil {
+		return false
+	}
+	return s.Has(v.m)
+}
+
+// Exists gets whether the specified selector matches something, even an
+// explicit null, like Map.Exists.
+func (v View) Exists(selector string) bool {
+	s, err := v.compile(selector)
+	if err != nil || v.m == nil {
+		return false
+	}
+	return s.Exists(v.m)
+}
+
+// Lookup gets the value using the specified selector or returns an
+// error, like Map.Lookup.
+func (v View) Lookup(selector string) (*Value, error) {
+	s, err := v.compile(selector)
+	if err != nil {
+		return nil, err
+	}
+	return s.Lookup(v.m)
+}
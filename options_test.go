@@ -0,0 +1,138 @@
+package objx_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/objx"
+)
+
+func TestWithOptionsSeparator(t *testing.T) {
+	m := objx.Map{
+		"hosts": objx.Map{
+			"example.com": objx.Map{"port": 80},
+		},
+	}
+	v := m.WithOptions(objx.Separator("/"))
+
+	assert.Equal(t, 80, v.Get("hosts/example.com/port").Data())
+	assert.True(t, v.Has("hosts/example.com"))
+	assert.True(t, v.Exists("hosts/example.com/port"))
+	assert.Nil(t, v.Get("hosts.example.com").Data())
+	assert.Nil(t, m.Get("hosts/example.com/port").Data())
+
+	v.Set("hosts/example.org/port", 443)
+	assert.Equal(t, 443, m.Get("hosts[example.org].port").Data())
+
+	removed, ok := v.Delete("hosts/example.org")
+	assert.True(t, ok)
+	assert.Equal(t, map[string]interface{}{"port": 443}, removed.Data())
+
+	_, err := v.Lookup("hosts/example.net")
+	assert.Error(t, err)
+
+	assert.Equal(t, m, v.Map())
+	assert.Equal(t, 80, m.WithOptions(objx.Separator(":")).Get("hosts:[example.com]:port").Data())
+	assert.Equal(t, 80, v.Get("hosts[?(@/port == 80)]/port").MustInterSlice()[0])
+	assert.Equal(t, []interface{}{80}, v.Get("//port").Data())
+}
+
+func TestWithOptionsInvalidSeparator(t *testing.T) {
+	m := objx.Map{"a": objx.Map{"b": 1}}
+
+	for _, sep := range []string{"", "::", "[", "*", `\`} {
+		v := m.WithOptions(objx.Separator(sep))
+
+		assert.Nil(t, v.Get("a.b").Data(), sep)
+		assert.False(t, v.Has("a.b"), sep)
+		_, err := v.Lookup("a.b")
+		assert.Error(t, err, sep)
+
+		_, err = objx.CompileWith("a.b", objx.Separator(sep))
+		assert.Error(t, err, sep)
+	}
+}
+
+func TestCompileWith(t *testing.T) {
+	m := objx.Map{"a.b": objx.Map{"c": 1}}
+
+	s, err := objx.CompileWith("a.b/c", objx.Separator("/"))
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, s.Get(m).Data())
+	assert.Equal(t, "a.b/c", s.String())
+}
+
+func TestWithOptionsConcurrent(t *testing.T) {
+	m := objx.Map{"a": objx.Map{"b": 1}, "a.b": 2}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, 1, m.WithOptions(objx.Separator("/")).Get("a/b").Data())
+		}()
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, 1, m.Get("a.b").Data())
+		}()
+	}
+	wg.Wait()
+}
+
+func TestWithOptionsCaseInsensitive(t *testing.T) {
+	m := objx.Map{
+		"Content-Type": "application/json",
+		"User": objx.Map{
+			"Name": "Mat",
+		},
+		"ID": 1,
+		"Id": 2,
+	}
+	v := m.WithOptions(objx.CaseInsensitive())
+
+	assert.Equal(t, "application/json", v.Get("content-type").Data())
+	assert.Equal(t, "Mat", v.Get("user.name").Data())
+	assert.Equal(t, "Mat", v.Get("USER[NAME]").Data())
+	assert.True(t, v.Has("USER.name"))
+	assert.Equal(t, 1, v.Get("id").Data())
+	assert.Equal(t, 2, v.Get("Id").Data())
+	assert.Nil(t, m.Get("content-type").Data())
+
+	v.Set("user.name", "Tyler")
+	v.Set("user.email", "tyler@example.com")
+	assert.Equal(t, objx.Map{"Name": "Tyler", "email": "tyler@example.com"}, m["User"])
+
+	removed, ok := v.Delete("content-TYPE")
+	assert.True(t, ok)
+	assert.Equal(t, "application/json", removed.Data())
+
+	s, err := objx.CompileWith("USER.NAME", objx.CaseInsensitive())
+	require.NoError(t, err)
+	assert.Equal(t, "Tyler", s.Get(m).Data())
+	assert.Nil(t, objx.MustCompile("USER.NAME").Get(m).Data())
+}
+
+func TestWithOptionsKeyNormalizer(t *testing.T) {
+	m := objx.Map{
+		"user_name":  "snake",
+		"userName":   "camel",
+		"first-name": "Mat",
+		"account": &struct {
+			OwnerID int `json:"owner_id"`
+		}{OwnerID: 7},
+	}
+	v := m.WithOptions(objx.KeyNormalizer(objx.FoldKey), objx.Separator("/"))
+
+	assert.Equal(t, "camel", v.Get("UserName").Data())
+	assert.Equal(t, "snake", v.Get("user_name").Data())
+	assert.Equal(t, "Mat", v.Get("FirstName").Data())
+	assert.Equal(t, 7, v.Get("Account/OwnerId").Data())
+
+	_, err := v.Lookup("LastName")
+	assert.Error(t, err)
+
+	assert.Equal(t, "username", objx.FoldKey("User_Name"))
+	assert.Equal(t, "contenttype", objx.FoldKey("Content-Type"))
+}
@@ -0,0 +1,85 @@
+package objx
+
+import "strconv"
+
+// PathsOption configures which selectors Paths returns.
+type PathsOption func(*pathsOptions)
+
+// pathsOptions holds the options of Paths.
+type pathsOptions struct {
+	// containers is whether maps and arrays are listed besides leaves
+	containers bool
+}
+
+// IncludeContainers makes Paths also return the selectors of the maps,
+// structs and arrays holding the leaves, each before its contents.
+func IncludeContainers() PathsOption {
+	return func(o *pathsOptions) {
+		o.containers = true
+	}
+}
+
+// Paths returns the selectors of every leaf of the Map, i.e. every value
+// that is neither a map, a struct nor an array, plus empty containers.
+//
+// Selectors are sorted by key, with array elements in index order, and
+// every one of them can be passed to Get:
+//
+//	objx.MustFromJSON(`{"b": [1, {"c": 2}], "a": "x"}`).Paths()
+//	// []string{"a", "b[0]", "b[1].c"}
+//
+// Keys that cannot be written plainly are quoted, e.g. `labels["app.kubernetes.io/name"]`.
+func (m Map) Paths(options ...PathsOption) []string {
+	return paths(m, PathSeparator, options)
+}
+
+// Paths returns the selectors of every leaf of the underlying Map, like
+// Map.Paths, using the separator of the View.
+func (v View) Paths(options ...PathsOption) []string {
+	return paths(v.m, v.opts.sep, options)
+}
+
+// paths returns the selectors of the leaves of m using sep.
+func paths(m Map, sep string, options []PathsOption) []string {
+	var o pathsOptions
+	for _, option := range options {
+		option(&o)
+	}
+	out := []string{}
+	visitPaths(m, sep, o.containers, func(selector string, _ interface{}) {
+		out = append(out, selector)
+	})
+	return out
+}
+
+// visitPaths calls visit with the selector and value of every leaf of m,
+// and of every container too if containers is true, in the order of
+// Paths.
+func visitPaths(m Map, sep string, containers bool, visit func(selector string, v interface{})) {
+	for _, key := range sortedKeys(m) {
+		visitPath(m[key], formatKey(key, sep, true), sep, containers, visit)
+	}
+}
+
+// visitPath calls visit for v and its descendants, given the selector of
+// v.
+func visitPath(v interface{}, selector, sep string, containers bool, visit func(selector string, v interface{})) {
+	keys, keyed := keysOf(v)
+	n := sliceLen(v)
+	if (!keyed || len(keys) == 0) && n == 0 {
+		visit(selector, v)
+		return
+	}
+
+	if containers {
+		visit(selector, v)
+	}
+	for _, key := range keys {
+		child, _ := getKey(v, key)
+		visitPath(child, selector+formatKey(key, sep, false), sep, containers, visit)
+	}
+	for i := 0; i < n; i++ {
+		child, _ := getIndex(v, i)
+		visitPath(child, selector+"["+strconv.Itoa(i)+"]", sep, containers, visit)
+	}
+}
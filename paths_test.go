@@ -0,0 +1,74 @@
+package objx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/objx"
+)
+
+func TestPaths(t *testing.T) {
+	m := objx.MustFromJSON(`{
+		"name": "Mat",
+		"books": [{"title": "Go", "tags": ["a", "b"]}, {"title": "objx"}],
+		"labels": {"app.kubernetes.io/name": "objx", "": 1, "*": 2},
+		"empty": {},
+		"none": [],
+		"nickname": null
+	}`)
+
+	paths := m.Paths()
+
+	assert.Equal(t, []string{
+		"books[0].tags[0]",
+		"books[0].tags[1]",
+		"books[0].title",
+		"books[1].title",
+		"empty",
+		`labels[""]`,
+		`labels["*"]`,
+		`labels["app.kubernetes.io/name"]`,
+		"name",
+		"nickname",
+		"none",
+	}, paths)
+	for _, path := range paths {
+		assert.True(t, m.Exists(path), path)
+	}
+}
+
+func TestPathsIncludeContainers(t *testing.T) {
+	m := objx.Map{
+		"books": []interface{}{
+			objx.Map{"title": "Go"},
+		},
+		"labels": map[string]string{"app": "objx"},
+		"name":   "Mat",
+	}
+
+	assert.Equal(t, []string{
+		"books",
+		"books[0]",
+		"books[0].title",
+		"labels",
+		"labels.app",
+		"name",
+	}, m.Paths(objx.IncludeContainers()))
+	assert.Equal(t, []string{}, objx.Map{}.Paths())
+}
+
+func TestPathsWithSeparator(t *testing.T) {
+	m := objx.Map{
+		"hosts": objx.Map{
+			"example.com": objx.Map{"port": 80},
+			"a/b":         1,
+		},
+	}
+	v := m.WithOptions(objx.Separator("/"))
+
+	paths := v.Paths()
+
+	assert.Equal(t, []string{`hosts["a/b"]`, "hosts/example.com/port"}, paths)
+	for _, path := range paths {
+		assert.True(t, v.Exists(path), path)
+	}
+}
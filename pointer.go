@@ -0,0 +1,170 @@
+package objx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// GetPointer gets the value at the RFC 6901 JSON Pointer and returns it
+// inside a new Value object.
+//
+// Reference tokens are separated by slashes, with `~1` standing for `/`
+// and `~0` for `~` (e.g. `/books/1/title`, `/paths/~1users`). A token
+// addresses a key of a map or, if it is an unsigned integer without
+// leading zeros, an element of an array. The empty pointer addresses
+// the whole Map.
+//
+// If it cannot find the value or the pointer is malformed, GetPointer
+// will return a nil value inside an instance of Value.
+func (m Map) GetPointer(pointer string) *Value {
+	segments, err := parsePointer(pointer)
+	if err != nil {
+		return &Value{missing: true}
+	}
+	if len(segments) == 0 {
+		return &Value{data: m}
+	}
+	a := accessor{}
+	a.access(m, segments)
+	return &Value{data: a.result, missing: !a.found}
+}
+
+// SetPointer sets the value at the RFC 6901 JSON Pointer and returns the
+// object on which SetPointer was called.
+//
+// Missing maps along the pointer are created. Setting the element right
+// after the end of an array, or the `-` token, appends to it. Setting any
+// other element out of range is a no-op, as is setting the empty pointer
+// or a malformed one.
+func (m Map) SetPointer(pointer string, value interface{}) Map {
+	segments, err := parsePointer(pointer)
+	if err != nil || len(segments) == 0 {
+		return m
+	}
+	a := accessor{mode: accessSet, value: value}
+	a.access(m, segments)
+	return m
+}
+
+// HasPointer gets whether there is something at the RFC 6901 JSON Pointer
+// or not.
+func (m Map) HasPointer(pointer string) bool {
+	return !m.GetPointer(pointer).IsNil()
+}
+
+// ExistsPointer gets whether the RFC 6901 JSON Pointer addresses
+// something, even an explicit null.
+func (m Map) ExistsPointer(pointer string) bool {
+	return m.GetPointer(pointer).IsPresent()
+}
+
+// DeletePointer removes the value at the RFC 6901 JSON Pointer and
+// returns it inside a new Value object, along with whether anything was
+// removed.
+//
+// Removing an element of an array shifts the elements after it.
+func (m Map) DeletePointer(pointer string) (*Value, bool) {
+	segments, err := parsePointer(pointer)
+	if err != nil || len(segments) == 0 {
+		return &Value{missing: true}, false
+	}
+	a := accessor{mode: accessDelete}
+	a.access(m, segments)
+	return &Value{data: a.result, missing: !a.found}, a.found
+}
+
+// SelectorToPointer converts a selector into the equivalent RFC 6901
+// JSON Pointer, e.g. `books[1].title` into `/books/1/title`.
+//
+// Returns an error if the selector is malformed or uses anything but
+// keys and non-negative indexes.
+func SelectorToPointer(selector string) (string, error) {
+	segments, err := parseSelector(selector, PathSeparator[0])
+	if err != nil {
+		return "", err
+	}
+
+	var pointer strings.Builder
+	for _, seg := range segments {
+		pointer.WriteByte('/')
+		switch {
+		case seg.kind == segmentKey:
+			pointer.WriteString(pointerEscaper.Replace(seg.key))
+		case seg.kind == segmentIndex && seg.index >= 0:
+			pointer.WriteString(strconv.Itoa(seg.index))
+		default:
+			return "", fmt.Errorf("objx: selector %q cannot be converted to a JSON Pointer", selector)
+		}
+	}
+	return pointer.String(), nil
+}
+
+// PointerToSelector converts an RFC 6901 JSON Pointer into the equivalent
+// selector, e.g. `/books/1/title` into `books[1].title`.
+//
+// As selectors tell map keys and array indexes apart, reference tokens
+// made of digits become indexes and every other token becomes a key.
+// Returns an error if the pointer is malformed or empty.
+func PointerToSelector(pointer string) (string, error) {
+	segments, err := parsePointer(pointer)
+	if err != nil {
+		return "", err
+	}
+	if len(segments) == 0 {
+		return "", fmt.Errorf("objx: JSON Pointer %q addresses the whole document", pointer)
+	}
+
+	var selector strings.Builder
+	for i, seg := range segments {
+		if index, ok := pointerIndex(seg.key); ok {
+			selector.WriteString("[" + strconv.Itoa(index) + "]")
+			continue
+		}
+		selector.WriteString(formatKey(seg.key, PathSeparator, i == 0))
+	}
+	return selector.String(), nil
+}
+
+var (
+	// pointerEscaper escapes a reference token of a JSON Pointer
+	pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+	// pointerUnescaper resolves the escapes of a reference token
+	pointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+)
+
+// parsePointer splits the JSON Pointer into its reference tokens.
+func parsePointer(pointer string) ([]segment, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if pointer[0] != '/' {
+		return nil, fmt.Errorf("objx: invalid JSON Pointer %q: missing leading '/' at offset 0", pointer)
+	}
+
+	var segments []segment
+	offset := 1
+	for _, token := range strings.Split(pointer[1:], "/") {
+		for i := 0; i < len(token); i++ {
+			if token[i] == '~' && (i+1 == len(token) || (token[i+1] != '0' && token[i+1] != '1')) {
+				return nil, fmt.Errorf("objx: invalid JSON Pointer %q: invalid escape at offset %d", pointer, offset+i)
+			}
+		}
+		segments = append(segments, segment{
+			kind: segmentPointer,
+			key:  pointerUnescaper.Replace(token),
+		})
+		offset += len(token) + 1
+	}
+	return segments, nil
+}
+
+// pointerIndex returns the array index held in a reference token, which
+// must be made of digits without leading zeros.
+func pointerIndex(token string) (int, bool) {
+	if token == "" || !isDigits(token) || (token[0] == '0' && len(token) > 1) {
+		return 0, false
+	}
+	index, err := strconv.Atoi(token)
+	return index, err == nil
+}
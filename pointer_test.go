@@ -0,0 +1,139 @@
+package objx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/objx"
+)
+
+func TestPointerGet(t *testing.T) {
+	m := objx.Map{
+		"books": []interface{}{
+			objx.Map{"title": "Go"},
+			map[string]interface{}{"title": "objx"},
+		},
+		"paths": objx.Map{
+			"/users": "list",
+			"a~b":    "tilde",
+		},
+		"":  "empty",
+		"0": "zero",
+	}
+
+	assert.Equal(t, m, m.GetPointer("").Data())
+	assert.Equal(t, "Go", m.GetPointer("/books/0/title").Data())
+	assert.Equal(t, "objx", m.GetPointer("/books/1/title").Data())
+	assert.Equal(t, "list", m.GetPointer("/paths/~1users").Data())
+	assert.Equal(t, "tilde", m.GetPointer("/paths/a~0b").Data())
+	assert.Equal(t, "empty", m.GetPointer("/").Data())
+	assert.Equal(t, "zero", m.GetPointer("/0").Data())
+	assert.Nil(t, m.GetPointer("/books/2/title").Data())
+	assert.Nil(t, m.GetPointer("/books/01").Data())
+	assert.Nil(t, m.GetPointer("/books/-").Data())
+	assert.Nil(t, m.GetPointer("books").Data())
+	assert.Nil(t, m.GetPointer("/paths/~2").Data())
+	assert.True(t, m.HasPointer("/books/1"))
+	assert.False(t, m.HasPointer("/books/1/author"))
+	assert.True(t, objx.Map{"a": nil}.ExistsPointer("/a"))
+	assert.False(t, objx.Map{"a": nil}.ExistsPointer("/b"))
+}
+
+func TestPointerSet(t *testing.T) {
+	m := objx.Map{
+		"books": []interface{}{
+			objx.Map{"title": "Go"},
+		},
+		"tags": []string{"one", "two"},
+	}
+
+	m.SetPointer("/books/0/title", "objx")
+	m.SetPointer("/tags/1", "three")
+	m.SetPointer("/tags/2", "four")
+	m.SetPointer("/tags/-", "five")
+	m.SetPointer("/tags/7", "eight")
+	m.SetPointer("/authors/-", "Tyler")
+	m.SetPointer("/author/name", "Mat")
+	m.SetPointer("/paths/~1users", "list")
+
+	assert.Equal(t, "objx", m.Get("books[0].title").Data())
+	assert.Equal(t, []string{"one", "three", "four", "five"}, m.Get("tags").Data())
+	assert.Equal(t, []interface{}{"Tyler"}, m.Get("authors").Data())
+	assert.Equal(t, "Mat", m.Get("author.name").Data())
+	assert.Equal(t, "list", m.Get(`paths["/users"]`).Data())
+}
+
+func TestPointerDelete(t *testing.T) {
+	m := objx.Map{
+		"books": []interface{}{
+			objx.Map{"title": "Go", "year": 2009},
+			objx.Map{"title": "objx"},
+		},
+		"tags": []string{"one", "two", "three"},
+	}
+
+	removed, ok := m.DeletePointer("/books/0/year")
+	assert.True(t, ok)
+	assert.Equal(t, 2009, removed.Data())
+	assert.Equal(t, objx.Map{"title": "Go"}, m.Get("books[0]").Data())
+
+	removed, ok = m.DeletePointer("/tags/1")
+	assert.True(t, ok)
+	assert.Equal(t, "two", removed.Data())
+	assert.Equal(t, []string{"one", "three"}, m.Get("tags").Data())
+
+	removed, ok = m.DeletePointer("/books/0")
+	assert.True(t, ok)
+	assert.Equal(t, objx.Map{"title": "Go"}, removed.Data())
+	assert.Equal(t, []interface{}{objx.Map{"title": "objx"}}, m.Get("books").Data())
+
+	_, ok = m.DeletePointer("/books/5")
+	assert.False(t, ok)
+	_, ok = m.DeletePointer("")
+	assert.False(t, ok)
+}
+
+func TestSelectorToPointer(t *testing.T) {
+	for selector, pointer := range map[string]string{
+		"books[1].title":      "/books/1/title",
+		`paths["/users"].get`: "/paths/~1users/get",
+		`a["~b"]`:             "/a/~0b",
+		`[""]`:                "/",
+	} {
+		actual, err := objx.SelectorToPointer(selector)
+
+		assert.NoError(t, err, selector)
+		assert.Equal(t, pointer, actual, selector)
+	}
+
+	for _, selector := range []string{"books[-1]", "books[*]", "books[1:]", "..title", "books["} {
+		_, err := objx.SelectorToPointer(selector)
+
+		assert.Error(t, err, selector)
+	}
+}
+
+func TestPointerToSelector(t *testing.T) {
+	for pointer, selector := range map[string]string{
+		"/books/1/title":     "books[1].title",
+		"/paths/~1users/get": "paths./users.get",
+		"/a.b/~0c":           `["a.b"].~c`,
+		"/":                  `[""]`,
+		"/*/01":              `["*"].01`,
+	} {
+		actual, err := objx.PointerToSelector(pointer)
+
+		assert.NoError(t, err, pointer)
+		assert.Equal(t, selector, actual, pointer)
+
+		roundTrip, err := objx.SelectorToPointer(actual)
+
+		assert.NoError(t, err, pointer)
+		assert.Equal(t, pointer, roundTrip)
+	}
+
+	for _, pointer := range []string{"", "books", "/a~", "/a~2"} {
+		_, err := objx.PointerToSelector(pointer)
+
+		assert.Error(t, err, pointer)
+	}
+}
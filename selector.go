@@ -0,0 +1,543 @@
+package objx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// selectorCacheSize is the maximum number of compiled selectors kept
+// by the internal cache used by Map.Get, Map.Set and friends.
+const selectorCacheSize = 1024
+
+// segmentKind describes what a single selector segment addresses.
+type segmentKind int
+
+const (
+	// segmentKey addresses a key of a map
+	segmentKey segmentKind = iota
+	// segmentIndex addresses an element of an array
+	segmentIndex
+	// segmentWildcard addresses every element of an array or map
+	segmentWildcard
+	// segmentRange addresses a part of an array
+	segmentRange
+	// segmentFilter addresses every element of an array or map
+	// matching a predicate
+	segmentFilter
+	// segmentPointer addresses a key of a map or an element of an array,
+	// like a JSON Pointer reference token
+	segmentPointer
+	// segmentDescent addresses the current value and all of its
+	// descendants
+	segmentDescent
+)
+
+// segment is a single step of a compiled selector.
+type segment struct {
+	kind  segmentKind
+	key   string
+	index int
+	// start and end are the optional bounds of a range
+	start, end *int
+	// step is the step of a range
+	step int
+	// filter is the predicate of a filter
+	filter filterExpr
+	// raw is the text of the segment in the selector
+	raw string
+	// offset is the position of the segment in the selector
+	offset int
+}
+
+// Selector is a compiled selector that can be used to get and set
+// values in a Map without parsing the selector again.
+//
+// A Selector is immutable and safe for concurrent use.
+//
+// # Example
+//
+//	title := objx.MustCompile("books[1].title")
+//	for _, m := range maps {
+//		fmt.Println(title.Get(m).Str())
+//	}
+type Selector struct {
+	raw      string
+	segments []segment
+	// multi is whether the selector may match more than one value
+	multi bool
+	// normalize is the key normalizer of the selector, if any
+	normalize func(key string) string
+}
+
+// Compile parses the selector and returns a Selector that can be used
+// to access values in a Map.
+//
+// Returns an error if the selector is malformed.
+func Compile(selector string) (*Selector, error) {
+	return CompileWith(selector)
+}
+
+// CompileWith parses the selector using the options and returns a
+// Selector that can be used to access values in a Map.
+//
+//	s, err := objx.CompileWith("hosts/example.com/port", objx.Separator("/"))
+//
+// Returns an error if the selector is malformed or an option is invalid.
+func CompileWith(selector string, options ...Option) (*Selector, error) {
+	o, err := newOptions(options)
+	if err != nil {
+		return nil, err
+	}
+	segments, err := parseSelector(selector, o.sep[0])
+	if err != nil {
+		return nil, err
+	}
+	s := &Selector{raw: selector, segments: segments, normalize: o.normalize}
+	for _, seg := range segments {
+		if seg.kind == segmentWildcard || seg.kind == segmentFilter || seg.kind == segmentDescent {
+			s.multi = true
+		}
+	}
+	return s, nil
+}
+
+// MustCompile parses the selector and returns a Selector that can be used
+// to access values in a Map.
+//
+// Panics if the selector is malformed.
+func MustCompile(selector string) *Selector {
+	s, err := Compile(selector)
+	if err != nil {
+		panic("objx: MustCompile failed with error: " + err.Error())
+	}
+	return s
+}
+
+// String returns the selector the Selector was compiled from.
+func (s *Selector) String() string {
+	return s.raw
+}
+
+// Get gets the value at the selector and returns it inside a new
+// Value object.
+//
+// If it cannot find the value, Get will return a nil value inside
+// an instance of Value. If the selector can match more than one value
+// (e.g. `books[*].title`), the Value holds a []interface{} of every match.
+func (s *Selector) Get(m Map) *Value {
+	a := accessor{multi: s.multi, normalize: s.normalize}
+	a.access(m, s.segments)
+	if s.multi {
+		if a.matches == nil {
+			a.matches = []interface{}{}
+		}
+		return &Value{data: a.matches, missing: !a.found}
+	}
+	return &Value{data: a.result, missing: !a.found}
+}
+
+// Set sets the value at the selector and returns the object on
+// which Set was called.
+//
+// If the selector can match more than one value, every match is set.
+func (s *Selector) Set(m Map, value interface{}) Map {
+	return s.SetWith(m, value)
+}
+
+// SetWith sets the value at the selector using the options and returns
+// the object on which SetWith was called.
+func (s *Selector) SetWith(m Map, value interface{}, options ...SetOption) Map {
+	a := accessor{mode: accessSet, value: value, multi: s.multi, normalize: s.normalize}
+	for _, option := range options {
+		option(&a)
+	}
+	a.access(m, s.segments)
+	return m
+}
+
+// Delete removes the value at the selector and returns it inside a new
+// Value object, along with whether anything was removed.
+//
+// If the selector can match more than one value, every match is removed
+// and the Value holds a []interface{} of the removed values.
+func (s *Selector) Delete(m Map) (*Value, bool) {
+	a := accessor{mode: accessDelete, multi: s.multi, normalize: s.normalize}
+	a.access(m, s.segments)
+	if s.multi {
+		if a.matches == nil {
+			a.matches = []interface{}{}
+		}
+		return &Value{data: a.matches, missing: !a.found}, a.found
+	}
+	return &Value{data: a.result, missing: !a.found}, a.found
+}
+
+// Has gets whether there is something at the selector or not.
+//
+// If the selector can match more than one value, Has returns whether
+// any of the matches is not nil.
+func (s *Selector) Has(m Map) bool {
+	v := s.Get(m)
+	if !s.multi {
+		return !v.IsNil()
+	}
+	for _, match := range v.MustInterSlice() {
+		if match != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Exists gets whether the selector matches something, even an explicit
+// null.
+func (s *Selector) Exists(m Map) bool {
+	return s.Get(m).IsPresent()
+}
+
+// cacheKey identifies a compiled selector in the cache.
+type cacheKey struct {
+	selector string
+	sep      string
+}
+
+// selectorCache holds the compiled selectors used by the Map accessors.
+var selectorCache = struct {
+	sync.RWMutex
+	selectors map[cacheKey]*Selector
+}{selectors: make(map[cacheKey]*Selector)}
+
+// compileCached returns the selector compiled with the options from the
+// cache, compiling and storing it first if needed.
+func compileCached(selector string, o parseOptions) (*Selector, error) {
+	key := cacheKey{selector: selector, sep: o.sep}
+	selectorCache.RLock()
+	s, ok := selectorCache.selectors[key]
+	selectorCache.RUnlock()
+	if ok {
+		return s.withNormalizer(o.normalize), nil
+	}
+
+	s, err := CompileWith(selector, Separator(o.sep))
+	if err != nil {
+		return nil, err
+	}
+
+	selectorCache.Lock()
+	if len(selectorCache.selectors) >= selectorCacheSize {
+		selectorCache.selectors = make(map[cacheKey]*Selector)
+	}
+	selectorCache.selectors[key] = s
+	selectorCache.Unlock()
+	return s.withNormalizer(o.normalize), nil
+}
+
+// withNormalizer returns a copy of the selector using the key normalizer,
+// or the selector itself if there is none.
+func (s *Selector) withNormalizer(normalize func(key string) string) *Selector {
+	if normalize == nil {
+		return s
+	}
+	normalized := *s
+	normalized.normalize = normalize
+	return &normalized
+}
+
+// parseSelector splits the selector into its segments.
+//
+// Keys are separated by sep, PathSeparator by default. Brackets either hold an array
+// index (e.g. `books[1]`) or a map key (e.g. `domains[example.com]`).
+// Keys inside brackets may be quoted (e.g. `labels["app.kubernetes.io/name"]`)
+// and any character can be escaped with a backslash (e.g. `metrics\[p99\]`).
+// Indexes may be negative to count from the end of the array and brackets
+// may hold a `[start:end:step]` range (e.g. `books[-1]`, `books[1:3]`).
+// A `*` key or index is a wildcard (e.g. `books[*].title`, `users.*.email`),
+// a `[?(...)]` filter selects the elements matching a predicate
+// and a double separator descends recursively (e.g. `..id`, `resources..arn`).
+func parseSelector(selector string, sep byte) ([]segment, error) {
+	if selector == "" {
+		return []segment{{kind: segmentKey}}, nil
+	}
+	p := &selectorParser{selector: selector, sep: sep}
+	return p.parse()
+}
+
+// selectorParser holds the state of a selector being parsed.
+type selectorParser struct {
+	selector string
+	pos      int
+	sep      byte
+}
+
+// parse parses the whole selector.
+func (p *selectorParser) parse() ([]segment, error) {
+	var segments []segment
+	for p.pos < len(p.selector) {
+		start, n := p.pos, len(segments)
+		switch c := p.selector[p.pos]; {
+		case c == '[':
+			seg, err := p.parseBracket()
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, seg)
+			if p.pos < len(p.selector) && p.selector[p.pos] != p.sep && p.selector[p.pos] != '[' {
+				return nil, p.errorf(p.pos, "unexpected %q", p.selector[p.pos])
+			}
+		case c == p.sep && p.pos+1 < len(p.selector) && p.selector[p.pos+1] == p.sep:
+			if p.pos+2 == len(p.selector) || p.selector[p.pos+2] == p.sep {
+				return nil, p.errorf(p.pos, "empty key")
+			}
+			segments = append(segments, segment{kind: segmentDescent})
+			p.pos += 2
+		case c == p.sep:
+			if p.pos == 0 || p.pos == len(p.selector)-1 {
+				return nil, p.errorf(p.pos, "empty key")
+			}
+			p.pos++
+		case c == ']':
+			return nil, p.errorf(p.pos, "unexpected ']'")
+		case c == '*' && p.atSegmentEnd(p.pos+1):
+			segments = append(segments, segment{kind: segmentWildcard})
+			p.pos++
+		default:
+			key, err := p.parseKey()
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, segment{kind: segmentKey, key: key})
+		}
+		if len(segments) > n {
+			segments[n].raw = p.selector[start:p.pos]
+			segments[n].offset = start
+		}
+	}
+	return segments, nil
+}
+
+// atSegmentEnd returns whether pos is at the end of the selector or
+// at the start of the next segment.
+func (p *selectorParser) atSegmentEnd(pos int) bool {
+	return pos == len(p.selector) || p.selector[pos] == p.sep || p.selector[pos] == '['
+}
+
+// parseKey parses an unquoted key up to the next separator or bracket.
+func (p *selectorParser) parseKey() (string, error) {
+	return p.parseUntil(func(c byte) bool {
+		return c == p.sep || c == '[' || c == ']'
+	})
+}
+
+// parseBracket parses a bracketed index or key, including the brackets.
+func (p *selectorParser) parseBracket() (segment, error) {
+	start := p.pos
+	p.pos++
+	if p.pos == len(p.selector) {
+		return segment{}, p.errorf(start, "unterminated '['")
+	}
+
+	var seg segment
+	switch c := p.selector[p.pos]; {
+	case c == '"' || c == '\'':
+		key, err := p.parseQuoted()
+		if err != nil {
+			return segment{}, err
+		}
+		seg = segment{kind: segmentKey, key: key}
+	case c == '?':
+		p.pos++
+		filter, err := p.parseFilter()
+		if err != nil {
+			return segment{}, err
+		}
+		seg = segment{kind: segmentFilter, filter: filter}
+	default:
+		contentStart := p.pos
+		key, err := p.parseUntil(func(c byte) bool {
+			return c == ']' || c == '['
+		})
+		if err != nil {
+			return segment{}, err
+		}
+		content := p.selector[contentStart:p.pos]
+		switch {
+		case content == "":
+			return segment{}, p.errorf(start, "empty brackets")
+		case content == "*":
+			seg = segment{kind: segmentWildcard}
+		case isInteger(content):
+			index, err := strconv.Atoi(content)
+			if err != nil {
+				return segment{}, p.errorf(contentStart, "index out of range")
+			}
+			seg = segment{kind: segmentIndex, index: index}
+		case isRange(content):
+			seg, err = p.parseRange(content, contentStart)
+			if err != nil {
+				return segment{}, err
+			}
+		default:
+			seg = segment{kind: segmentKey, key: key}
+		}
+	}
+
+	if p.pos == len(p.selector) {
+		return segment{}, p.errorf(start, "unterminated '['")
+	}
+	if p.selector[p.pos] != ']' {
+		return segment{}, p.errorf(p.pos, "unexpected %q", p.selector[p.pos])
+	}
+	p.pos++
+	return seg, nil
+}
+
+// parseRange parses the content of a `[start:end:step]` range.
+func (p *selectorParser) parseRange(content string, offset int) (segment, error) {
+	seg := segment{kind: segmentRange, step: 1}
+	for i, part := range strings.Split(content, ":") {
+		if part != "" {
+			n, err := strconv.Atoi(part)
+			if err != nil {
+				return segment{}, p.errorf(offset, "index out of range")
+			}
+			switch i {
+			case 0:
+				seg.start = &n
+			case 1:
+				seg.end = &n
+			default:
+				if n == 0 {
+					return segment{}, p.errorf(offset, "range step cannot be zero")
+				}
+				seg.step = n
+			}
+		}
+		offset += len(part) + 1
+	}
+	return seg, nil
+}
+
+// parseQuoted parses a key enclosed in single or double quotes.
+func (p *selectorParser) parseQuoted() (string, error) {
+	start := p.pos
+	quote := p.selector[p.pos]
+	p.pos++
+	key, err := p.parseUntil(func(c byte) bool {
+		return c == quote
+	})
+	if err != nil {
+		return "", err
+	}
+	if p.pos == len(p.selector) {
+		return "", p.errorf(start, "unterminated quote")
+	}
+	p.pos++
+	return key, nil
+}
+
+// parseUntil reads characters up to the first one for which stop returns
+// true or the end of the selector, resolving backslash escapes.
+func (p *selectorParser) parseUntil(stop func(c byte) bool) (string, error) {
+	start := p.pos
+	var buf []byte
+	escaped := false
+	for p.pos < len(p.selector) {
+		c := p.selector[p.pos]
+		if c == '\\' {
+			if p.pos+1 == len(p.selector) {
+				return "", p.errorf(p.pos, "unterminated escape")
+			}
+			if !escaped {
+				buf = append(buf, p.selector[start:p.pos]...)
+				escaped = true
+			}
+			buf = append(buf, p.selector[p.pos+1])
+			p.pos += 2
+			continue
+		}
+		if stop(c) {
+			break
+		}
+		if escaped {
+			buf = append(buf, c)
+		}
+		p.pos++
+	}
+	if escaped {
+		return string(buf), nil
+	}
+	return p.selector[start:p.pos], nil
+}
+
+// formatKey formats a key as a selector segment, quoting it if it could
+// otherwise be mistaken for something else. Keys that are not first are
+// prefixed with sep.
+func formatKey(key, sep string, first bool) string {
+	if key == "" || key == "*" || strings.ContainsAny(key, sep+`[]\"'`) {
+		return "[" + quoteKey(key) + "]"
+	}
+	if first {
+		return key
+	}
+	return sep + key
+}
+
+// quoteKey encloses a key in double quotes, escaping backslashes and
+// double quotes.
+func quoteKey(key string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(key) + `"`
+}
+
+// SyntaxError describes a malformed selector.
+type SyntaxError struct {
+	// Selector is the malformed selector
+	Selector string
+	// Offset is the position in bytes of the error in Selector
+	Offset int
+	// Msg describes the error
+	Msg string
+}
+
+// Error returns a description of the malformed selector.
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("objx: invalid selector %q: %s at offset %d", e.Selector, e.Msg, e.Offset)
+}
+
+// errorf returns an error describing a malformed selector.
+func (p *selectorParser) errorf(offset int, format string, args ...interface{}) error {
+	return &SyntaxError{Selector: p.selector, Offset: offset, Msg: fmt.Sprintf(format, args...)}
+}
+
+// isDigits returns whether s is made of ASCII digits only.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// isInteger returns whether s is a decimal integer with an optional
+// minus sign.
+func isInteger(s string) bool {
+	s = strings.TrimPrefix(s, "-")
+	return s != "" && isDigits(s)
+}
+
+// isRange returns whether s is a `start:end:step` range where every part
+// is either empty or an integer.
+func isRange(s string) bool {
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return false
+	}
+	for _, part := range parts {
+		if part != "" && !isInteger(part) {
+			return false
+		}
+	}
+	return true
+}
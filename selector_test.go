@@ -0,0 +1,231 @@
+package objx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/objx"
+)
+
+func TestSelectorCompile(t *testing.T) {
+	s, err := objx.Compile("books[1].chapters[0].title")
+
+	require.NoError(t, err)
+	require.NotNil(t, s)
+	assert.Equal(t, "books[1].chapters[0].title", s.String())
+}
+
+func TestSelectorCompileWithError(t *testing.T) {
+	for _, selector := range []string{
+		"names[]",
+		"names1]]",
+		"names[1]]",
+		"names[[1]]",
+		"names[[1]",
+		"names[[1",
+		"names[1]x",
+		".names",
+		"names.",
+		"names...first",
+		"names..",
+		"..",
+		`names["first]`,
+		`names["first"x]`,
+		`names\`,
+		`names['first`,
+	} {
+		s, err := objx.Compile(selector)
+
+		assert.Error(t, err, selector)
+		assert.Nil(t, s)
+	}
+
+	assert.Panics(t, func() {
+		objx.MustCompile("names[")
+	})
+}
+
+func TestSelectorGet(t *testing.T) {
+	m := objx.Map{
+		"books": []interface{}{
+			objx.Map{"title": "Go"},
+			map[string]interface{}{
+				"title":    "objx",
+				"chapters": []string{"one", "two"},
+			},
+		},
+		"domains": objx.Map{
+			"example.com": "example",
+		},
+	}
+
+	assert.Equal(t, "Go", objx.MustCompile("books[0].title").Get(m).Data())
+	assert.Equal(t, "objx", objx.MustCompile("books[1][title]").Get(m).Data())
+	assert.Equal(t, "two", objx.MustCompile("books[1].chapters[1]").Get(m).Data())
+	assert.Equal(t, "example", objx.MustCompile("domains[example.com]").Get(m).Data())
+	assert.Nil(t, objx.MustCompile("books[2].title").Get(m).Data())
+	assert.Nil(t, objx.MustCompile("books.title").Get(m).Data())
+	assert.Nil(t, objx.MustCompile("domains[0]").Get(m).Data())
+}
+
+func TestSelectorSet(t *testing.T) {
+	m := objx.Map{
+		"books": []interface{}{
+			objx.Map{"title": "Go"},
+		},
+		"tags": []string{"one", "two"},
+	}
+
+	objx.MustCompile("books[0].title").Set(m, "objx")
+	objx.MustCompile("tags[1]").Set(m, "three")
+	objx.MustCompile("tags[0]").Set(m, 1)
+	objx.MustCompile("author.name").Set(m, "Mat")
+
+	assert.Equal(t, "objx", m.Get("books[0].title").Data())
+	assert.Equal(t, []string{"one", "three"}, m.Get("tags").Data())
+	assert.Equal(t, "Mat", m.Get("author.name").Data())
+}
+
+func TestSelectorSetArrayElement(t *testing.T) {
+	m := objx.Map{
+		"names": []interface{}{"Tyler", "Mat"},
+	}
+
+	m.Set("names[1]", "Ryer")
+	m.Set("names[2]", "Captain")
+	m.Set("names[4]", "Jen")
+	m.Set("names[-4]", "Jen")
+
+	assert.Equal(t, []interface{}{"Tyler", "Ryer", "Captain"}, m.Get("names").Data())
+}
+
+func TestSelectorSetCreatesArrays(t *testing.T) {
+	m := objx.Map{
+		"books": []interface{}{
+			objx.Map{"title": "Go"},
+		},
+	}
+
+	m.Set("tags[0]", "go")
+	m.Set("books[1].title", "objx")
+	m.Set("matrix[0][0]", 1)
+	m.Set("books[0].authors[0].name", "Tyler")
+
+	assert.Equal(t, []interface{}{"go"}, m.Get("tags").Data())
+	assert.Equal(t, "objx", m.Get("books[1].title").Data())
+	assert.Equal(t, []interface{}{[]interface{}{1}}, m.Get("matrix").Data())
+	assert.Equal(t, "Tyler", m.Get("books[0].authors[0].name").Data())
+}
+
+func TestSelectorSetWithPadSlices(t *testing.T) {
+	m := objx.Map{
+		"names": []interface{}{"Tyler"},
+		"ages":  []int{30},
+	}
+
+	m.SetWith("names[2]", "Mat", objx.PadSlices())
+	m.SetWith("ages[2]", 40, objx.PadSlices())
+	m.SetWith("ages[3]", "old", objx.PadSlices())
+	m.SetWith("groups[1].name", "admins", objx.PadSlices())
+	m.Set("names[5]", "Ryer")
+
+	assert.Equal(t, []interface{}{"Tyler", nil, "Mat"}, m.Get("names").Data())
+	assert.Equal(t, []int{30, 0, 40}, m.Get("ages").Data())
+	assert.Equal(t, []interface{}{nil, map[string]interface{}{"name": "admins"}}, m.Get("groups").Data())
+}
+
+func TestSelectorSetWithPadSlicesHugeIndex(t *testing.T) {
+	m := objx.Map{
+		"names": []interface{}{"Tyler"},
+		"ages":  []int{30},
+	}
+
+	m.SetWith("names[9223372036854775807]", "Mat", objx.PadSlices())
+	m.SetWith("ages[9223372036854775807]", 40, objx.PadSlices())
+	m.SetWith("names[100000000000]", "Mat", objx.PadSlices())
+	m.SetWith("groups[100000000000].name", "admins", objx.PadSlices())
+	m.SetWith("names[65537]", "Mat", objx.PadSlices())
+
+	assert.Equal(t, []interface{}{"Tyler"}, m.Get("names").Data())
+	assert.Equal(t, []int{30}, m.Get("ages").Data())
+	assert.False(t, m.Has("groups"))
+
+	m.SetWith("names[65536]", "Mat", objx.PadSlices())
+	assert.Len(t, m.Get("names").InterSlice(), 65537)
+	assert.Equal(t, "Mat", m.Get("names[65536]").Data())
+}
+
+func TestSelectorSetFailureLeavesMapUntouched(t *testing.T) {
+	m := objx.Map{
+		"names": []interface{}{"Tyler"},
+		"empty": objx.Map{},
+		"book":  &testBook{Title: "Go"},
+	}
+
+	for _, selector := range []string{
+		"tags[1]",
+		"tags[-1]",
+		"a.b.*",
+		"empty.b.*",
+		"names[3].first",
+		"names[1][2]",
+		"matrix[0][-1]",
+		"book.coauthor.secret.x",
+	} {
+		m.Set(selector, "x")
+	}
+
+	assert.Equal(t, objx.Map{
+		"names": []interface{}{"Tyler"},
+		"empty": objx.Map{},
+		"book":  &testBook{Title: "Go"},
+	}, m)
+}
+
+func TestSelectorInvalidOnMap(t *testing.T) {
+	m := objx.Map{"names[": "Tyler"}
+
+	assert.Nil(t, m.Get("names[").Data())
+	assert.False(t, m.Has("names["))
+
+	m.Set("names[", "Mat")
+	assert.Equal(t, "Tyler", m["names["])
+}
+
+var benchmarkMap = objx.Map{
+	"books": []interface{}{
+		objx.Map{
+			"title": "Go",
+			"chapters": []interface{}{
+				objx.Map{"title": "Intro"},
+			},
+		},
+	},
+}
+
+func BenchmarkSelectorCompileAndGet(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		s, _ := objx.Compile("books[0].chapters[0].title")
+		_ = s.Get(benchmarkMap)
+	}
+}
+
+func BenchmarkSelectorCompiledGet(b *testing.B) {
+	s := objx.MustCompile("books[0].chapters[0].title")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = s.Get(benchmarkMap)
+	}
+}
+
+func BenchmarkMapGet(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = benchmarkMap.Get("books[0].chapters[0].title")
+	}
+}
+
+func BenchmarkMapSet(b *testing.B) {
+	m := objx.Map{}
+	for i := 0; i < b.N; i++ {
+		m.Set("one.two.three", i)
+	}
+}
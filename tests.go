@@ -3,12 +3,37 @@ package objx
 // Has gets whether there is something at the specified selector
 // or not.
 //
-// If m is nil, Has will always return false.
+// If m is nil, Has will always return false. If the selector contains
+// a wildcard, Has returns whether any of its matches is not nil.
 func (m Map) Has(selector string) bool {
 	if m == nil {
 		return false
 	}
-	return !m.Get(selector).IsNil()
+	s, err := compileCached(selector, defaultOptions)
+	if err != nil {
+		return false
+	}
+	return s.Has(m)
+}
+
+// Exists gets whether the specified selector matches something, even
+// an explicit null, unlike Has which treats null and missing values alike.
+//
+//	m := objx.MustFromJSON(`{"a": null}`)
+//	m.Has("a")    // false
+//	m.Exists("a") // true
+//
+// If m is nil, Exists will always return false. If the selector contains
+// a wildcard, Exists returns whether it has any match.
+func (m Map) Exists(selector string) bool {
+	if m == nil {
+		return false
+	}
+	s, err := compileCached(selector, defaultOptions)
+	if err != nil {
+		return false
+	}
+	return s.Exists(m)
 }
 
 // IsNil gets whether the data is nil or not.
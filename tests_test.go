@@ -22,3 +22,42 @@ func TestHas(t *testing.T) {
 
 	assert.False(t, m.Has("nothing"))
 }
+
+func TestExists(t *testing.T) {
+	m := objx.MustFromJSON(`{"name": "Mat", "nickname": null, "tags": [null], "books": [{"title": null}]}`)
+
+	assert.True(t, m.Exists("name"))
+	assert.True(t, m.Exists("nickname"))
+	assert.True(t, m.Exists("tags[0]"))
+	assert.True(t, m.Exists("books[*].title"))
+	assert.False(t, m.Has("nickname"))
+	assert.False(t, m.Has("books[*].title"))
+
+	assert.False(t, m.Exists("nope"))
+	assert.False(t, m.Exists("tags[1]"))
+	assert.False(t, m.Exists("books[*].nope"))
+	assert.False(t, m.Exists("name["))
+
+	m = nil
+
+	assert.False(t, m.Exists("nothing"))
+}
+
+func TestIsPresentAndIsNull(t *testing.T) {
+	m := objx.MustFromJSON(`{"name": "Mat", "nickname": null}`)
+
+	assert.True(t, m.Get("name").IsPresent())
+	assert.False(t, m.Get("name").IsNull())
+	assert.True(t, m.Get("nickname").IsPresent())
+	assert.True(t, m.Get("nickname").IsNull())
+	assert.False(t, m.Get("nope").IsPresent())
+	assert.False(t, m.Get("nope").IsNull())
+	assert.True(t, m.Get("nope").IsNil())
+	assert.True(t, m.GetPointer("/nickname").IsNull())
+	assert.False(t, m.GetPointer("/nope").IsPresent())
+
+	removed, _ := m.Delete("nickname")
+	assert.True(t, removed.IsNull())
+	removed, _ = m.Delete("nickname")
+	assert.False(t, removed.IsPresent())
+}
@@ -0,0 +1,246 @@
+package objx
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+// unixMilliThreshold is the absolute value from which numbers are
+// considered Unix timestamps in milliseconds rather than seconds. It is
+// September 2001 in milliseconds and the year 33658 in seconds.
+const unixMilliThreshold = 1e12
+
+/*
+   Time (time.Time and []time.Time)
+*/
+
+// AsTime gets the value as a time.Time.
+//
+// Strings are parsed with the specified layouts, tried in order, or
+// with time.RFC3339Nano if there are none. Numbers are Unix timestamps in
+// seconds, or in milliseconds if their absolute value is at least 1e12,
+// and are returned in UTC:
+//
+//	m := objx.MustFromJSON(`{"created": "2024-01-02T15:04:05Z", "updated": 1704207845}`)
+//	m.Get("created").AsTime() // 2024-01-02 15:04:05 +0000 UTC, nil
+//	m.Get("updated").AsTime() // 2024-01-02 15:04:05 +0000 UTC, nil
+//
+// Returns a *ConversionError if the value is missing or cannot be
+// converted.
+func (v *Value) AsTime(layouts ...string) (time.Time, error) {
+	switch data := v.data.(type) {
+	case time.Time:
+		return data, nil
+	case *time.Time:
+		if data != nil {
+			return *data, nil
+		}
+	case string:
+		if len(layouts) == 0 {
+			layouts = []string{time.RFC3339Nano}
+		}
+		for _, layout := range layouts {
+			if t, err := time.Parse(layout, strings.TrimSpace(data)); err == nil {
+				return t, nil
+			}
+		}
+		return time.Time{}, v.conversionError("time.Time", ErrTypeMismatch)
+	}
+
+	n, err := v.numeric("time.Time")
+	if err != nil {
+		return time.Time{}, err
+	}
+	switch n.kind {
+	case numericUint:
+		if n.u > math.MaxInt64 {
+			return time.Time{}, v.conversionError("time.Time", ErrOverflow)
+		}
+		n = numericValue{kind: numericInt, i: int64(n.u)}
+		fallthrough
+	case numericInt:
+		if n.i >= unixMilliThreshold || n.i <= -unixMilliThreshold {
+			return time.UnixMilli(n.i).UTC(), nil
+		}
+		return time.Unix(n.i, 0).UTC(), nil
+	}
+	f := n.f
+	if f >= unixMilliThreshold || f <= -unixMilliThreshold {
+		f /= 1000
+	}
+	if err := checkIntegral(math.Trunc(f), -(1 << 63), 1<<63); err != nil {
+		return time.Time{}, v.conversionError("time.Time", err)
+	}
+	sec, frac := math.Modf(f)
+	return time.Unix(int64(sec), int64(math.Round(frac*1e9))).UTC(), nil
+}
+
+// Time gets the value as a time.Time, like AsTime, or returns the zero
+// time if it cannot be converted.
+func (v *Value) Time(layouts ...string) time.Time {
+	t, _ := v.AsTime(layouts...)
+	return t
+}
+
+// MustTime gets the value as a time.Time, like AsTime.
+//
+// Panics if the object cannot be converted to a time.Time.
+func (v *Value) MustTime(layouts ...string) time.Time {
+	t, err := v.AsTime(layouts...)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// AsTimeSlice gets the value as a []time.Time, converting each element
+// of a slice like AsTime, like AsInt64Slice.
+func (v *Value) AsTimeSlice(layouts ...string) ([]time.Time, error) {
+	return convertSlice(v, "[]time.Time", func(element *Value) (time.Time, error) {
+		return element.AsTime(layouts...)
+	})
+}
+
+// TimeSlice gets the value as a []time.Time, like AsTimeSlice, or
+// returns nil if it cannot be converted.
+func (v *Value) TimeSlice(layouts ...string) []time.Time {
+	s, _ := v.AsTimeSlice(layouts...)
+	return s
+}
+
+// MustTimeSlice gets the value as a []time.Time, like AsTimeSlice.
+//
+// Panics if the object is not a slice or an element cannot be converted.
+func (v *Value) MustTimeSlice(layouts ...string) []time.Time {
+	s, err := v.AsTimeSlice(layouts...)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// IsTime gets whether the object contained is a time.Time or not.
+func (v *Value) IsTime() bool {
+	return isType[time.Time](v)
+}
+
+// IsTimeSlice gets whether the object contained is a []time.Time or not.
+func (v *Value) IsTimeSlice() bool {
+	return isType[[]time.Time](v)
+}
+
+/*
+   Duration (time.Duration and []time.Duration)
+*/
+
+// AsDuration gets the value as a time.Duration.
+//
+// Strings are parsed with time.ParseDuration and numbers are seconds:
+//
+//	m := objx.MustFromJSON(`{"timeout": "1m30s", "retry": 2.5}`)
+//	m.Get("timeout").AsDuration() // 1m30s, nil
+//	m.Get("retry").AsDuration() // 2.5s, nil
+//
+// Returns a *ConversionError if the value is missing or cannot be
+// converted.
+func (v *Value) AsDuration() (time.Duration, error) {
+	switch data := v.data.(type) {
+	case time.Duration:
+		return data, nil
+	case string:
+		d, err := time.ParseDuration(strings.TrimSpace(data))
+		if err != nil {
+			return 0, v.conversionError("time.Duration", ErrTypeMismatch)
+		}
+		return d, nil
+	}
+
+	n, err := v.numeric("time.Duration")
+	if err != nil {
+		return 0, err
+	}
+	switch n.kind {
+	case numericInt:
+		if n.i > math.MaxInt64/int64(time.Second) || n.i < math.MinInt64/int64(time.Second) {
+			return 0, v.conversionError("time.Duration", ErrOverflow)
+		}
+		return time.Duration(n.i) * time.Second, nil
+	case numericUint:
+		if n.u > math.MaxInt64/uint64(time.Second) {
+			return 0, v.conversionError("time.Duration", ErrOverflow)
+		}
+		return time.Duration(n.u) * time.Second, nil
+	}
+	ns := math.Round(n.f * float64(time.Second))
+	if err := checkIntegral(ns, -(1 << 63), 1<<63); err != nil {
+		return 0, v.conversionError("time.Duration", err)
+	}
+	return time.Duration(ns), nil
+}
+
+// Duration gets the value as a time.Duration, like AsDuration, returns
+// the optionalDefault value or 0 if it cannot be converted.
+func (v *Value) Duration(optionalDefault ...time.Duration) time.Duration {
+	if d, err := v.AsDuration(); err == nil {
+		return d
+	}
+	if len(optionalDefault) == 1 {
+		return optionalDefault[0]
+	}
+	return 0
+}
+
+// MustDuration gets the value as a time.Duration, like AsDuration.
+//
+// Panics if the object cannot be converted to a time.Duration.
+func (v *Value) MustDuration() time.Duration {
+	d, err := v.AsDuration()
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// AsDurationSlice gets the value as a []time.Duration, converting each
+// element of a slice like AsDuration, like AsInt64Slice.
+func (v *Value) AsDurationSlice() ([]time.Duration, error) {
+	return convertSlice(v, "[]time.Duration", (*Value).AsDuration)
+}
+
+// DurationSlice gets the value as a []time.Duration, like
+// AsDurationSlice, returns the optionalDefault value or nil if it cannot
+// be converted.
+func (v *Value) DurationSlice(optionalDefault ...[]time.Duration) []time.Duration {
+	if s, err := v.AsDurationSlice(); err == nil {
+		return s
+	}
+	if len(optionalDefault) == 1 {
+		return optionalDefault[0]
+	}
+	return nil
+}
+
+// MustDurationSlice gets the value as a []time.Duration, like
+// AsDurationSlice.
+//
+// Panics if the object is not a slice or an element cannot be converted.
+func (v *Value) MustDurationSlice() []time.Duration {
+	s, err := v.AsDurationSlice()
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// IsDuration gets whether the object contained is a time.Duration or
+// not.
+func (v *Value) IsDuration() bool {
+	return isType[time.Duration](v)
+}
+
+// IsDurationSlice gets whether the object contained is a
+// []time.Duration or not.
+func (v *Value) IsDurationSlice() bool {
+	return isType[[]time.Duration](v)
+}
@@ -0,0 +1,108 @@
+package objx_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/objx"
+)
+
+func TestTime(t *testing.T) {
+	expected := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	m := objx.MustFromJSON(`{"rfc3339": "2024-01-02T15:04:05Z", "seconds": 1704207845, "millis": 1704207845000, "fraction": 1704207845.25, "date": "2024-01-02", "word": "yesterday", "null": null}`)
+	m["time"] = expected
+	m["pointer"] = &expected
+	m["number"] = objx.MustFromJSONWith(`{"n": 1704207845}`, objx.UseNumber())["n"]
+
+	for _, selector := range []string{"rfc3339", "seconds", "millis", "time", "pointer", "number"} {
+		tm, err := m.Get(selector).AsTime()
+
+		assert.NoError(t, err, selector)
+		assert.True(t, expected.Equal(tm), selector)
+		assert.True(t, expected.Equal(m.Get(selector).Time()), selector)
+		assert.True(t, expected.Equal(m.Get(selector).MustTime()), selector)
+	}
+
+	assert.Equal(t, expected.Add(250*time.Millisecond), m.Get("fraction").Time())
+	assert.Equal(t, time.UTC, m.Get("seconds").Time().Location())
+	assert.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), m.Get("date").Time(time.RFC3339, time.DateOnly))
+	assert.True(t, m.Get("date").Time().IsZero())
+	assert.True(t, m.Get("time").IsTime())
+	assert.False(t, m.Get("rfc3339").IsTime())
+
+	for selector, expectedErr := range map[string]error{"date": objx.ErrTypeMismatch, "word": objx.ErrTypeMismatch, "null": objx.ErrTypeMismatch, "missing": objx.ErrNotFound} {
+		tm, err := m.Get(selector).AsTime()
+
+		assert.True(t, tm.IsZero(), selector)
+		assert.True(t, errors.Is(err, expectedErr), selector)
+	}
+	assert.Panics(t, func() {
+		m.Get("word").MustTime()
+	})
+}
+
+func TestTimeSlice(t *testing.T) {
+	m := objx.MustFromJSON(`{"times": ["2024-01-02T15:04:05Z", 1704207845], "bad": [1704207845, "soon"]}`)
+	m["typed"] = []time.Time{{}}
+
+	times := m.Get("times").MustTimeSlice()
+	require.Len(t, times, 2)
+	assert.True(t, times[0].Equal(times[1]))
+	assert.True(t, m.Get("typed").IsTimeSlice())
+	assert.False(t, m.Get("times").IsTimeSlice())
+	assert.Nil(t, m.Get("bad").TimeSlice())
+
+	_, err := m.Get("bad").AsTimeSlice()
+	var elementErr *objx.ElementError
+	require.True(t, errors.As(err, &elementErr))
+	assert.Equal(t, 1, elementErr.Index)
+	assert.Panics(t, func() {
+		m.Get("bad").MustTimeSlice()
+	})
+}
+
+func TestDuration(t *testing.T) {
+	m := objx.MustFromJSON(`{"string": "1m30s", "seconds": 90, "fraction": 1.5, "word": "soon", "huge": 1e300}`)
+	m["duration"] = 90 * time.Second
+
+	for _, selector := range []string{"string", "seconds", "duration"} {
+		d, err := m.Get(selector).AsDuration()
+
+		assert.NoError(t, err, selector)
+		assert.Equal(t, 90*time.Second, d, selector)
+		assert.Equal(t, 90*time.Second, m.Get(selector).MustDuration(), selector)
+	}
+
+	assert.Equal(t, 1500*time.Millisecond, m.Get("fraction").Duration())
+	assert.Equal(t, time.Duration(0), m.Get("word").Duration())
+	assert.Equal(t, time.Second, m.Get("missing").Duration(time.Second))
+	assert.True(t, m.Get("duration").IsDuration())
+	assert.False(t, m.Get("string").IsDuration())
+
+	_, err := m.Get("word").AsDuration()
+	assert.True(t, errors.Is(err, objx.ErrTypeMismatch))
+	_, err = m.Get("huge").AsDuration()
+	assert.True(t, errors.Is(err, objx.ErrOverflow))
+	assert.Panics(t, func() {
+		m.Get("missing").MustDuration()
+	})
+}
+
+func TestDurationSlice(t *testing.T) {
+	m := objx.MustFromJSON(`{"durations": ["1s", 2], "bad": ["1s", true]}`)
+	m["typed"] = []time.Duration{time.Second}
+
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second}, m.Get("durations").MustDurationSlice())
+	assert.Equal(t, []time.Duration{time.Minute}, m.Get("bad").DurationSlice([]time.Duration{time.Minute}))
+	assert.True(t, m.Get("typed").IsDurationSlice())
+	assert.False(t, m.Get("durations").IsDurationSlice())
+
+	_, err := m.Get("bad").AsDurationSlice()
+	var elementErr *objx.ElementError
+	require.True(t, errors.As(err, &elementErr))
+	assert.Equal(t, 1, elementErr.Index)
+	assert.Panics(t, func() {
+		m.Get("bad").MustDurationSlice()
+	})
+}
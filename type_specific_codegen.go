@@ -7,122 +7,75 @@ package objx
 // Inter gets the value as a interface{}, returns the optionalDefault
 // value or a system default object if the value is the wrong type.
 func (v *Value) Inter(optionalDefault ...interface{}) interface{} {
-	if s, ok := v.data.(interface{}); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return nil
+	return typedOr(v, optionalDefault)
 }
 
 // MustInter gets the value as a interface{}.
 //
 // Panics if the object is not a interface{}.
 func (v *Value) MustInter() interface{} {
-	return v.data.(interface{})
+	return mustTyped[interface{}](v)
 }
 
-// InterSlice gets the value as a []interface{}, returns the optionalDefault
-// value or nil if the value is not a []interface{}.
+// InterSlice gets the value as a []interface{}, converting the elements of
+// any other slice like As does. Returns the optionalDefault value or
+// nil if the value is not a slice or an element cannot be converted.
 func (v *Value) InterSlice(optionalDefault ...[]interface{}) []interface{} {
-	if s, ok := v.data.([]interface{}); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return nil
+	return sliceOr(v, optionalDefault)
 }
 
-// MustInterSlice gets the value as a []interface{}.
+// MustInterSlice gets the value as a []interface{}, like InterSlice.
 //
-// Panics if the object is not a []interface{}.
+// Panics if the object is not a slice or an element cannot be converted.
 func (v *Value) MustInterSlice() []interface{} {
-	return v.data.([]interface{})
+	return mustSliceOf[interface{}](v)
 }
 
-// IsInter gets whether the object contained is a interface{} or not.
+// IsInter gets whether the object contained is a interface{}, or a
+// json.Number that Inter gets as one, or not.
 func (v *Value) IsInter() bool {
-	_, ok := v.data.(interface{})
-	return ok
+	return isTyped[interface{}](v)
 }
 
 // IsInterSlice gets whether the object contained is a []interface{} or not.
 func (v *Value) IsInterSlice() bool {
-	_, ok := v.data.([]interface{})
-	return ok
+	return isType[[]interface{}](v)
 }
 
 // EachInter calls the specified callback for each object
 // in the []interface{}.
 //
-// Panics if the object is the wrong type.
+// Panics if the object cannot be converted to a []interface{}.
 func (v *Value) EachInter(callback func(int, interface{}) bool) *Value {
-	for index, val := range v.MustInterSlice() {
-		carryon := callback(index, val)
-		if !carryon {
-			break
-		}
-	}
-	return v
+	return eachOf(v, callback)
 }
 
 // WhereInter uses the specified decider function to select items
 // from the []interface{}.  The object contained in the result will contain
 // only the selected items.
 func (v *Value) WhereInter(decider func(int, interface{}) bool) *Value {
-	var selected []interface{}
-	v.EachInter(func(index int, val interface{}) bool {
-		shouldSelect := decider(index, val)
-		if !shouldSelect {
-			selected = append(selected, val)
-		}
-		return true
-	})
-	return &Value{data: selected}
+	return whereOf(v, decider)
 }
 
 // GroupInter uses the specified grouper function to group the items
 // keyed by the return of the grouper.  The object contained in the
 // result will contain a map[string][]interface{}.
 func (v *Value) GroupInter(grouper func(int, interface{}) string) *Value {
-	groups := make(map[string][]interface{})
-	v.EachInter(func(index int, val interface{}) bool {
-		group := grouper(index, val)
-		if _, ok := groups[group]; !ok {
-			groups[group] = make([]interface{}, 0)
-		}
-		groups[group] = append(groups[group], val)
-		return true
-	})
-	return &Value{data: groups}
+	return groupOf(v, grouper)
 }
 
 // ReplaceInter uses the specified function to replace each interface{}s
 // by iterating each item.  The data in the returned result will be a
 // []interface{} containing the replaced items.
 func (v *Value) ReplaceInter(replacer func(int, interface{}) interface{}) *Value {
-	arr := v.MustInterSlice()
-	replaced := make([]interface{}, len(arr))
-	v.EachInter(func(index int, val interface{}) bool {
-		replaced[index] = replacer(index, val)
-		return true
-	})
-	return &Value{data: replaced}
+	return replaceOf(v, replacer)
 }
 
 // CollectInter uses the specified collector function to collect a value
 // for each of the interface{}s in the slice.  The data returned will be a
 // []interface{}.
 func (v *Value) CollectInter(collector func(int, interface{}) interface{}) *Value {
-	arr := v.MustInterSlice()
-	collected := make([]interface{}, len(arr))
-	v.EachInter(func(index int, val interface{}) bool {
-		collected[index] = collector(index, val)
-		return true
-	})
-	return &Value{data: collected}
+	return collectOf(v, collector)
 }
 
 /*
@@ -132,122 +85,75 @@ func (v *Value) CollectInter(collector func(int, interface{}) interface{}) *Valu
 // Bool gets the value as a bool, returns the optionalDefault
 // value or a system default object if the value is the wrong type.
 func (v *Value) Bool(optionalDefault ...bool) bool {
-	if s, ok := v.data.(bool); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return false
+	return typedOr(v, optionalDefault)
 }
 
 // MustBool gets the value as a bool.
 //
 // Panics if the object is not a bool.
 func (v *Value) MustBool() bool {
-	return v.data.(bool)
+	return mustTyped[bool](v)
 }
 
-// BoolSlice gets the value as a []bool, returns the optionalDefault
-// value or nil if the value is not a []bool.
+// BoolSlice gets the value as a []bool, converting the elements of
+// any other slice like As does. Returns the optionalDefault value or
+// nil if the value is not a slice or an element cannot be converted.
 func (v *Value) BoolSlice(optionalDefault ...[]bool) []bool {
-	if s, ok := v.data.([]bool); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return nil
+	return sliceOr(v, optionalDefault)
 }
 
-// MustBoolSlice gets the value as a []bool.
+// MustBoolSlice gets the value as a []bool, like BoolSlice.
 //
-// Panics if the object is not a []bool.
+// Panics if the object is not a slice or an element cannot be converted.
 func (v *Value) MustBoolSlice() []bool {
-	return v.data.([]bool)
+	return mustSliceOf[bool](v)
 }
 
-// IsBool gets whether the object contained is a bool or not.
+// IsBool gets whether the object contained is a bool, or a
+// json.Number that Bool gets as one, or not.
 func (v *Value) IsBool() bool {
-	_, ok := v.data.(bool)
-	return ok
+	return isTyped[bool](v)
 }
 
 // IsBoolSlice gets whether the object contained is a []bool or not.
 func (v *Value) IsBoolSlice() bool {
-	_, ok := v.data.([]bool)
-	return ok
+	return isType[[]bool](v)
 }
 
 // EachBool calls the specified callback for each object
 // in the []bool.
 //
-// Panics if the object is the wrong type.
+// Panics if the object cannot be converted to a []bool.
 func (v *Value) EachBool(callback func(int, bool) bool) *Value {
-	for index, val := range v.MustBoolSlice() {
-		carryon := callback(index, val)
-		if !carryon {
-			break
-		}
-	}
-	return v
+	return eachOf(v, callback)
 }
 
 // WhereBool uses the specified decider function to select items
 // from the []bool.  The object contained in the result will contain
 // only the selected items.
 func (v *Value) WhereBool(decider func(int, bool) bool) *Value {
-	var selected []bool
-	v.EachBool(func(index int, val bool) bool {
-		shouldSelect := decider(index, val)
-		if !shouldSelect {
-			selected = append(selected, val)
-		}
-		return true
-	})
-	return &Value{data: selected}
+	return whereOf(v, decider)
 }
 
 // GroupBool uses the specified grouper function to group the items
 // keyed by the return of the grouper.  The object contained in the
 // result will contain a map[string][]bool.
 func (v *Value) GroupBool(grouper func(int, bool) string) *Value {
-	groups := make(map[string][]bool)
-	v.EachBool(func(index int, val bool) bool {
-		group := grouper(index, val)
-		if _, ok := groups[group]; !ok {
-			groups[group] = make([]bool, 0)
-		}
-		groups[group] = append(groups[group], val)
-		return true
-	})
-	return &Value{data: groups}
+	return groupOf(v, grouper)
 }
 
 // ReplaceBool uses the specified function to replace each bools
 // by iterating each item.  The data in the returned result will be a
 // []bool containing the replaced items.
 func (v *Value) ReplaceBool(replacer func(int, bool) bool) *Value {
-	arr := v.MustBoolSlice()
-	replaced := make([]bool, len(arr))
-	v.EachBool(func(index int, val bool) bool {
-		replaced[index] = replacer(index, val)
-		return true
-	})
-	return &Value{data: replaced}
+	return replaceOf(v, replacer)
 }
 
 // CollectBool uses the specified collector function to collect a value
 // for each of the bools in the slice.  The data returned will be a
 // []interface{}.
 func (v *Value) CollectBool(collector func(int, bool) interface{}) *Value {
-	arr := v.MustBoolSlice()
-	collected := make([]interface{}, len(arr))
-	v.EachBool(func(index int, val bool) bool {
-		collected[index] = collector(index, val)
-		return true
-	})
-	return &Value{data: collected}
+	return collectOf(v, collector)
 }
 
 /*
@@ -257,122 +163,75 @@ func (v *Value) CollectBool(collector func(int, bool) interface{}) *Value {
 // Str gets the value as a string, returns the optionalDefault
 // value or a system default object if the value is the wrong type.
 func (v *Value) Str(optionalDefault ...string) string {
-	if s, ok := v.data.(string); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return ""
+	return typedOr(v, optionalDefault)
 }
 
 // MustStr gets the value as a string.
 //
 // Panics if the object is not a string.
 func (v *Value) MustStr() string {
-	return v.data.(string)
+	return mustTyped[string](v)
 }
 
-// StrSlice gets the value as a []string, returns the optionalDefault
-// value or nil if the value is not a []string.
+// StrSlice gets the value as a []string, converting the elements of
+// any other slice like As does. Returns the optionalDefault value or
+// nil if the value is not a slice or an element cannot be converted.
 func (v *Value) StrSlice(optionalDefault ...[]string) []string {
-	if s, ok := v.data.([]string); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return nil
+	return sliceOr(v, optionalDefault)
 }
 
-// MustStrSlice gets the value as a []string.
+// MustStrSlice gets the value as a []string, like StrSlice.
 //
-// Panics if the object is not a []string.
+// Panics if the object is not a slice or an element cannot be converted.
 func (v *Value) MustStrSlice() []string {
-	return v.data.([]string)
+	return mustSliceOf[string](v)
 }
 
-// IsStr gets whether the object contained is a string or not.
+// IsStr gets whether the object contained is a string, or a
+// json.Number that Str gets as one, or not.
 func (v *Value) IsStr() bool {
-	_, ok := v.data.(string)
-	return ok
+	return isTyped[string](v)
 }
 
 // IsStrSlice gets whether the object contained is a []string or not.
 func (v *Value) IsStrSlice() bool {
-	_, ok := v.data.([]string)
-	return ok
+	return isType[[]string](v)
 }
 
 // EachStr calls the specified callback for each object
 // in the []string.
 //
-// Panics if the object is the wrong type.
+// Panics if the object cannot be converted to a []string.
 func (v *Value) EachStr(callback func(int, string) bool) *Value {
-	for index, val := range v.MustStrSlice() {
-		carryon := callback(index, val)
-		if !carryon {
-			break
-		}
-	}
-	return v
+	return eachOf(v, callback)
 }
 
 // WhereStr uses the specified decider function to select items
 // from the []string.  The object contained in the result will contain
 // only the selected items.
 func (v *Value) WhereStr(decider func(int, string) bool) *Value {
-	var selected []string
-	v.EachStr(func(index int, val string) bool {
-		shouldSelect := decider(index, val)
-		if !shouldSelect {
-			selected = append(selected, val)
-		}
-		return true
-	})
-	return &Value{data: selected}
+	return whereOf(v, decider)
 }
 
 // GroupStr uses the specified grouper function to group the items
 // keyed by the return of the grouper.  The object contained in the
 // result will contain a map[string][]string.
 func (v *Value) GroupStr(grouper func(int, string) string) *Value {
-	groups := make(map[string][]string)
-	v.EachStr(func(index int, val string) bool {
-		group := grouper(index, val)
-		if _, ok := groups[group]; !ok {
-			groups[group] = make([]string, 0)
-		}
-		groups[group] = append(groups[group], val)
-		return true
-	})
-	return &Value{data: groups}
+	return groupOf(v, grouper)
 }
 
 // ReplaceStr uses the specified function to replace each strings
 // by iterating each item.  The data in the returned result will be a
 // []string containing the replaced items.
 func (v *Value) ReplaceStr(replacer func(int, string) string) *Value {
-	arr := v.MustStrSlice()
-	replaced := make([]string, len(arr))
-	v.EachStr(func(index int, val string) bool {
-		replaced[index] = replacer(index, val)
-		return true
-	})
-	return &Value{data: replaced}
+	return replaceOf(v, replacer)
 }
 
 // CollectStr uses the specified collector function to collect a value
 // for each of the strings in the slice.  The data returned will be a
 // []interface{}.
 func (v *Value) CollectStr(collector func(int, string) interface{}) *Value {
-	arr := v.MustStrSlice()
-	collected := make([]interface{}, len(arr))
-	v.EachStr(func(index int, val string) bool {
-		collected[index] = collector(index, val)
-		return true
-	})
-	return &Value{data: collected}
+	return collectOf(v, collector)
 }
 
 /*
@@ -382,18 +241,12 @@ func (v *Value) CollectStr(collector func(int, string) interface{}) *Value {
 // Int gets the value as a int, returns the optionalDefault
 // value or a system default object if the value is the wrong type.
 func (v *Value) Int(optionalDefault ...int) int {
-	if s, ok := v.data.(int); ok {
-		return s
-	}
 	if s, ok := v.data.(float64); ok {
 		if float64(int(s)) == s {
 			return int(s)
 		}
 	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return 0
+	return typedOr(v, optionalDefault)
 }
 
 // MustInt gets the value as a int.
@@ -405,109 +258,68 @@ func (v *Value) MustInt() int {
 			return int(s)
 		}
 	}
-	return v.data.(int)
+	return mustTyped[int](v)
 }
 
-// IntSlice gets the value as a []int, returns the optionalDefault
-// value or nil if the value is not a []int.
+// IntSlice gets the value as a []int, converting the elements of
+// any other slice like As does. Returns the optionalDefault value or
+// nil if the value is not a slice or an element cannot be converted.
 func (v *Value) IntSlice(optionalDefault ...[]int) []int {
-	if s, ok := v.data.([]int); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return nil
+	return sliceOr(v, optionalDefault)
 }
 
-// MustIntSlice gets the value as a []int.
+// MustIntSlice gets the value as a []int, like IntSlice.
 //
-// Panics if the object is not a []int.
+// Panics if the object is not a slice or an element cannot be converted.
 func (v *Value) MustIntSlice() []int {
-	return v.data.([]int)
+	return mustSliceOf[int](v)
 }
 
-// IsInt gets whether the object contained is a int or not.
+// IsInt gets whether the object contained is a int, or a
+// json.Number that Int gets as one, or not.
 func (v *Value) IsInt() bool {
-	_, ok := v.data.(int)
-	return ok
+	return isTyped[int](v)
 }
 
 // IsIntSlice gets whether the object contained is a []int or not.
 func (v *Value) IsIntSlice() bool {
-	_, ok := v.data.([]int)
-	return ok
+	return isType[[]int](v)
 }
 
 // EachInt calls the specified callback for each object
 // in the []int.
 //
-// Panics if the object is the wrong type.
+// Panics if the object cannot be converted to a []int.
 func (v *Value) EachInt(callback func(int, int) bool) *Value {
-	for index, val := range v.MustIntSlice() {
-		carryon := callback(index, val)
-		if !carryon {
-			break
-		}
-	}
-	return v
+	return eachOf(v, callback)
 }
 
 // WhereInt uses the specified decider function to select items
 // from the []int.  The object contained in the result will contain
 // only the selected items.
 func (v *Value) WhereInt(decider func(int, int) bool) *Value {
-	var selected []int
-	v.EachInt(func(index int, val int) bool {
-		shouldSelect := decider(index, val)
-		if !shouldSelect {
-			selected = append(selected, val)
-		}
-		return true
-	})
-	return &Value{data: selected}
+	return whereOf(v, decider)
 }
 
 // GroupInt uses the specified grouper function to group the items
 // keyed by the return of the grouper.  The object contained in the
 // result will contain a map[string][]int.
 func (v *Value) GroupInt(grouper func(int, int) string) *Value {
-	groups := make(map[string][]int)
-	v.EachInt(func(index int, val int) bool {
-		group := grouper(index, val)
-		if _, ok := groups[group]; !ok {
-			groups[group] = make([]int, 0)
-		}
-		groups[group] = append(groups[group], val)
-		return true
-	})
-	return &Value{data: groups}
+	return groupOf(v, grouper)
 }
 
 // ReplaceInt uses the specified function to replace each ints
 // by iterating each item.  The data in the returned result will be a
 // []int containing the replaced items.
 func (v *Value) ReplaceInt(replacer func(int, int) int) *Value {
-	arr := v.MustIntSlice()
-	replaced := make([]int, len(arr))
-	v.EachInt(func(index int, val int) bool {
-		replaced[index] = replacer(index, val)
-		return true
-	})
-	return &Value{data: replaced}
+	return replaceOf(v, replacer)
 }
 
 // CollectInt uses the specified collector function to collect a value
 // for each of the ints in the slice.  The data returned will be a
 // []interface{}.
 func (v *Value) CollectInt(collector func(int, int) interface{}) *Value {
-	arr := v.MustIntSlice()
-	collected := make([]interface{}, len(arr))
-	v.EachInt(func(index int, val int) bool {
-		collected[index] = collector(index, val)
-		return true
-	})
-	return &Value{data: collected}
+	return collectOf(v, collector)
 }
 
 /*
@@ -517,122 +329,75 @@ func (v *Value) CollectInt(collector func(int, int) interface{}) *Value {
 // Int8 gets the value as a int8, returns the optionalDefault
 // value or a system default object if the value is the wrong type.
 func (v *Value) Int8(optionalDefault ...int8) int8 {
-	if s, ok := v.data.(int8); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return 0
+	return typedOr(v, optionalDefault)
 }
 
 // MustInt8 gets the value as a int8.
 //
 // Panics if the object is not a int8.
 func (v *Value) MustInt8() int8 {
-	return v.data.(int8)
+	return mustTyped[int8](v)
 }
 
-// Int8Slice gets the value as a []int8, returns the optionalDefault
-// value or nil if the value is not a []int8.
+// Int8Slice gets the value as a []int8, converting the elements of
+// any other slice like As does. Returns the optionalDefault value or
+// nil if the value is not a slice or an element cannot be converted.
 func (v *Value) Int8Slice(optionalDefault ...[]int8) []int8 {
-	if s, ok := v.data.([]int8); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return nil
+	return sliceOr(v, optionalDefault)
 }
 
-// MustInt8Slice gets the value as a []int8.
+// MustInt8Slice gets the value as a []int8, like Int8Slice.
 //
-// Panics if the object is not a []int8.
+// Panics if the object is not a slice or an element cannot be converted.
 func (v *Value) MustInt8Slice() []int8 {
-	return v.data.([]int8)
+	return mustSliceOf[int8](v)
 }
 
-// IsInt8 gets whether the object contained is a int8 or not.
+// IsInt8 gets whether the object contained is a int8, or a
+// json.Number that Int8 gets as one, or not.
 func (v *Value) IsInt8() bool {
-	_, ok := v.data.(int8)
-	return ok
+	return isTyped[int8](v)
 }
 
 // IsInt8Slice gets whether the object contained is a []int8 or not.
 func (v *Value) IsInt8Slice() bool {
-	_, ok := v.data.([]int8)
-	return ok
+	return isType[[]int8](v)
 }
 
 // EachInt8 calls the specified callback for each object
 // in the []int8.
 //
-// Panics if the object is the wrong type.
+// Panics if the object cannot be converted to a []int8.
 func (v *Value) EachInt8(callback func(int, int8) bool) *Value {
-	for index, val := range v.MustInt8Slice() {
-		carryon := callback(index, val)
-		if !carryon {
-			break
-		}
-	}
-	return v
+	return eachOf(v, callback)
 }
 
 // WhereInt8 uses the specified decider function to select items
 // from the []int8.  The object contained in the result will contain
 // only the selected items.
 func (v *Value) WhereInt8(decider func(int, int8) bool) *Value {
-	var selected []int8
-	v.EachInt8(func(index int, val int8) bool {
-		shouldSelect := decider(index, val)
-		if !shouldSelect {
-			selected = append(selected, val)
-		}
-		return true
-	})
-	return &Value{data: selected}
+	return whereOf(v, decider)
 }
 
 // GroupInt8 uses the specified grouper function to group the items
 // keyed by the return of the grouper.  The object contained in the
 // result will contain a map[string][]int8.
 func (v *Value) GroupInt8(grouper func(int, int8) string) *Value {
-	groups := make(map[string][]int8)
-	v.EachInt8(func(index int, val int8) bool {
-		group := grouper(index, val)
-		if _, ok := groups[group]; !ok {
-			groups[group] = make([]int8, 0)
-		}
-		groups[group] = append(groups[group], val)
-		return true
-	})
-	return &Value{data: groups}
+	return groupOf(v, grouper)
 }
 
 // ReplaceInt8 uses the specified function to replace each int8s
 // by iterating each item.  The data in the returned result will be a
 // []int8 containing the replaced items.
 func (v *Value) ReplaceInt8(replacer func(int, int8) int8) *Value {
-	arr := v.MustInt8Slice()
-	replaced := make([]int8, len(arr))
-	v.EachInt8(func(index int, val int8) bool {
-		replaced[index] = replacer(index, val)
-		return true
-	})
-	return &Value{data: replaced}
+	return replaceOf(v, replacer)
 }
 
 // CollectInt8 uses the specified collector function to collect a value
 // for each of the int8s in the slice.  The data returned will be a
 // []interface{}.
 func (v *Value) CollectInt8(collector func(int, int8) interface{}) *Value {
-	arr := v.MustInt8Slice()
-	collected := make([]interface{}, len(arr))
-	v.EachInt8(func(index int, val int8) bool {
-		collected[index] = collector(index, val)
-		return true
-	})
-	return &Value{data: collected}
+	return collectOf(v, collector)
 }
 
 /*
@@ -642,122 +407,75 @@ func (v *Value) CollectInt8(collector func(int, int8) interface{}) *Value {
 // Int16 gets the value as a int16, returns the optionalDefault
 // value or a system default object if the value is the wrong type.
 func (v *Value) Int16(optionalDefault ...int16) int16 {
-	if s, ok := v.data.(int16); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return 0
+	return typedOr(v, optionalDefault)
 }
 
 // MustInt16 gets the value as a int16.
 //
 // Panics if the object is not a int16.
 func (v *Value) MustInt16() int16 {
-	return v.data.(int16)
+	return mustTyped[int16](v)
 }
 
-// Int16Slice gets the value as a []int16, returns the optionalDefault
-// value or nil if the value is not a []int16.
+// Int16Slice gets the value as a []int16, converting the elements of
+// any other slice like As does. Returns the optionalDefault value or
+// nil if the value is not a slice or an element cannot be converted.
 func (v *Value) Int16Slice(optionalDefault ...[]int16) []int16 {
-	if s, ok := v.data.([]int16); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return nil
+	return sliceOr(v, optionalDefault)
 }
 
-// MustInt16Slice gets the value as a []int16.
+// MustInt16Slice gets the value as a []int16, like Int16Slice.
 //
-// Panics if the object is not a []int16.
+// Panics if the object is not a slice or an element cannot be converted.
 func (v *Value) MustInt16Slice() []int16 {
-	return v.data.([]int16)
+	return mustSliceOf[int16](v)
 }
 
-// IsInt16 gets whether the object contained is a int16 or not.
+// IsInt16 gets whether the object contained is a int16, or a
+// json.Number that Int16 gets as one, or not.
 func (v *Value) IsInt16() bool {
-	_, ok := v.data.(int16)
-	return ok
+	return isTyped[int16](v)
 }
 
 // IsInt16Slice gets whether the object contained is a []int16 or not.
 func (v *Value) IsInt16Slice() bool {
-	_, ok := v.data.([]int16)
-	return ok
+	return isType[[]int16](v)
 }
 
 // EachInt16 calls the specified callback for each object
 // in the []int16.
 //
-// Panics if the object is the wrong type.
+// Panics if the object cannot be converted to a []int16.
 func (v *Value) EachInt16(callback func(int, int16) bool) *Value {
-	for index, val := range v.MustInt16Slice() {
-		carryon := callback(index, val)
-		if !carryon {
-			break
-		}
-	}
-	return v
+	return eachOf(v, callback)
 }
 
 // WhereInt16 uses the specified decider function to select items
 // from the []int16.  The object contained in the result will contain
 // only the selected items.
 func (v *Value) WhereInt16(decider func(int, int16) bool) *Value {
-	var selected []int16
-	v.EachInt16(func(index int, val int16) bool {
-		shouldSelect := decider(index, val)
-		if !shouldSelect {
-			selected = append(selected, val)
-		}
-		return true
-	})
-	return &Value{data: selected}
+	return whereOf(v, decider)
 }
 
 // GroupInt16 uses the specified grouper function to group the items
 // keyed by the return of the grouper.  The object contained in the
 // result will contain a map[string][]int16.
 func (v *Value) GroupInt16(grouper func(int, int16) string) *Value {
-	groups := make(map[string][]int16)
-	v.EachInt16(func(index int, val int16) bool {
-		group := grouper(index, val)
-		if _, ok := groups[group]; !ok {
-			groups[group] = make([]int16, 0)
-		}
-		groups[group] = append(groups[group], val)
-		return true
-	})
-	return &Value{data: groups}
+	return groupOf(v, grouper)
 }
 
 // ReplaceInt16 uses the specified function to replace each int16s
 // by iterating each item.  The data in the returned result will be a
 // []int16 containing the replaced items.
 func (v *Value) ReplaceInt16(replacer func(int, int16) int16) *Value {
-	arr := v.MustInt16Slice()
-	replaced := make([]int16, len(arr))
-	v.EachInt16(func(index int, val int16) bool {
-		replaced[index] = replacer(index, val)
-		return true
-	})
-	return &Value{data: replaced}
+	return replaceOf(v, replacer)
 }
 
 // CollectInt16 uses the specified collector function to collect a value
 // for each of the int16s in the slice.  The data returned will be a
 // []interface{}.
 func (v *Value) CollectInt16(collector func(int, int16) interface{}) *Value {
-	arr := v.MustInt16Slice()
-	collected := make([]interface{}, len(arr))
-	v.EachInt16(func(index int, val int16) bool {
-		collected[index] = collector(index, val)
-		return true
-	})
-	return &Value{data: collected}
+	return collectOf(v, collector)
 }
 
 /*
@@ -767,122 +485,75 @@ func (v *Value) CollectInt16(collector func(int, int16) interface{}) *Value {
 // Int32 gets the value as a int32, returns the optionalDefault
 // value or a system default object if the value is the wrong type.
 func (v *Value) Int32(optionalDefault ...int32) int32 {
-	if s, ok := v.data.(int32); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return 0
+	return typedOr(v, optionalDefault)
 }
 
 // MustInt32 gets the value as a int32.
 //
 // Panics if the object is not a int32.
 func (v *Value) MustInt32() int32 {
-	return v.data.(int32)
+	return mustTyped[int32](v)
 }
 
-// Int32Slice gets the value as a []int32, returns the optionalDefault
-// value or nil if the value is not a []int32.
+// Int32Slice gets the value as a []int32, converting the elements of
+// any other slice like As does. Returns the optionalDefault value or
+// nil if the value is not a slice or an element cannot be converted.
 func (v *Value) Int32Slice(optionalDefault ...[]int32) []int32 {
-	if s, ok := v.data.([]int32); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return nil
+	return sliceOr(v, optionalDefault)
 }
 
-// MustInt32Slice gets the value as a []int32.
+// MustInt32Slice gets the value as a []int32, like Int32Slice.
 //
-// Panics if the object is not a []int32.
+// Panics if the object is not a slice or an element cannot be converted.
 func (v *Value) MustInt32Slice() []int32 {
-	return v.data.([]int32)
+	return mustSliceOf[int32](v)
 }
 
-// IsInt32 gets whether the object contained is a int32 or not.
+// IsInt32 gets whether the object contained is a int32, or a
+// json.Number that Int32 gets as one, or not.
 func (v *Value) IsInt32() bool {
-	_, ok := v.data.(int32)
-	return ok
+	return isTyped[int32](v)
 }
 
 // IsInt32Slice gets whether the object contained is a []int32 or not.
 func (v *Value) IsInt32Slice() bool {
-	_, ok := v.data.([]int32)
-	return ok
+	return isType[[]int32](v)
 }
 
 // EachInt32 calls the specified callback for each object
 // in the []int32.
 //
-// Panics if the object is the wrong type.
+// Panics if the object cannot be converted to a []int32.
 func (v *Value) EachInt32(callback func(int, int32) bool) *Value {
-	for index, val := range v.MustInt32Slice() {
-		carryon := callback(index, val)
-		if !carryon {
-			break
-		}
-	}
-	return v
+	return eachOf(v, callback)
 }
 
 // WhereInt32 uses the specified decider function to select items
 // from the []int32.  The object contained in the result will contain
 // only the selected items.
 func (v *Value) WhereInt32(decider func(int, int32) bool) *Value {
-	var selected []int32
-	v.EachInt32(func(index int, val int32) bool {
-		shouldSelect := decider(index, val)
-		if !shouldSelect {
-			selected = append(selected, val)
-		}
-		return true
-	})
-	return &Value{data: selected}
+	return whereOf(v, decider)
 }
 
 // GroupInt32 uses the specified grouper function to group the items
 // keyed by the return of the grouper.  The object contained in the
 // result will contain a map[string][]int32.
 func (v *Value) GroupInt32(grouper func(int, int32) string) *Value {
-	groups := make(map[string][]int32)
-	v.EachInt32(func(index int, val int32) bool {
-		group := grouper(index, val)
-		if _, ok := groups[group]; !ok {
-			groups[group] = make([]int32, 0)
-		}
-		groups[group] = append(groups[group], val)
-		return true
-	})
-	return &Value{data: groups}
+	return groupOf(v, grouper)
 }
 
 // ReplaceInt32 uses the specified function to replace each int32s
 // by iterating each item.  The data in the returned result will be a
 // []int32 containing the replaced items.
 func (v *Value) ReplaceInt32(replacer func(int, int32) int32) *Value {
-	arr := v.MustInt32Slice()
-	replaced := make([]int32, len(arr))
-	v.EachInt32(func(index int, val int32) bool {
-		replaced[index] = replacer(index, val)
-		return true
-	})
-	return &Value{data: replaced}
+	return replaceOf(v, replacer)
 }
 
 // CollectInt32 uses the specified collector function to collect a value
 // for each of the int32s in the slice.  The data returned will be a
 // []interface{}.
 func (v *Value) CollectInt32(collector func(int, int32) interface{}) *Value {
-	arr := v.MustInt32Slice()
-	collected := make([]interface{}, len(arr))
-	v.EachInt32(func(index int, val int32) bool {
-		collected[index] = collector(index, val)
-		return true
-	})
-	return &Value{data: collected}
+	return collectOf(v, collector)
 }
 
 /*
@@ -892,122 +563,75 @@ func (v *Value) CollectInt32(collector func(int, int32) interface{}) *Value {
 // Int64 gets the value as a int64, returns the optionalDefault
 // value or a system default object if the value is the wrong type.
 func (v *Value) Int64(optionalDefault ...int64) int64 {
-	if s, ok := v.data.(int64); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return 0
+	return typedOr(v, optionalDefault)
 }
 
 // MustInt64 gets the value as a int64.
 //
 // Panics if the object is not a int64.
 func (v *Value) MustInt64() int64 {
-	return v.data.(int64)
+	return mustTyped[int64](v)
 }
 
-// Int64Slice gets the value as a []int64, returns the optionalDefault
-// value or nil if the value is not a []int64.
+// Int64Slice gets the value as a []int64, converting the elements of
+// any other slice like As does. Returns the optionalDefault value or
+// nil if the value is not a slice or an element cannot be converted.
 func (v *Value) Int64Slice(optionalDefault ...[]int64) []int64 {
-	if s, ok := v.data.([]int64); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return nil
+	return sliceOr(v, optionalDefault)
 }
 
-// MustInt64Slice gets the value as a []int64.
+// MustInt64Slice gets the value as a []int64, like Int64Slice.
 //
-// Panics if the object is not a []int64.
+// Panics if the object is not a slice or an element cannot be converted.
 func (v *Value) MustInt64Slice() []int64 {
-	return v.data.([]int64)
+	return mustSliceOf[int64](v)
 }
 
-// IsInt64 gets whether the object contained is a int64 or not.
+// IsInt64 gets whether the object contained is a int64, or a
+// json.Number that Int64 gets as one, or not.
 func (v *Value) IsInt64() bool {
-	_, ok := v.data.(int64)
-	return ok
+	return isTyped[int64](v)
 }
 
 // IsInt64Slice gets whether the object contained is a []int64 or not.
 func (v *Value) IsInt64Slice() bool {
-	_, ok := v.data.([]int64)
-	return ok
+	return isType[[]int64](v)
 }
 
 // EachInt64 calls the specified callback for each object
 // in the []int64.
 //
-// Panics if the object is the wrong type.
+// Panics if the object cannot be converted to a []int64.
 func (v *Value) EachInt64(callback func(int, int64) bool) *Value {
-	for index, val := range v.MustInt64Slice() {
-		carryon := callback(index, val)
-		if !carryon {
-			break
-		}
-	}
-	return v
+	return eachOf(v, callback)
 }
 
 // WhereInt64 uses the specified decider function to select items
 // from the []int64.  The object contained in the result will contain
 // only the selected items.
 func (v *Value) WhereInt64(decider func(int, int64) bool) *Value {
-	var selected []int64
-	v.EachInt64(func(index int, val int64) bool {
-		shouldSelect := decider(index, val)
-		if !shouldSelect {
-			selected = append(selected, val)
-		}
-		return true
-	})
-	return &Value{data: selected}
+	return whereOf(v, decider)
 }
 
 // GroupInt64 uses the specified grouper function to group the items
 // keyed by the return of the grouper.  The object contained in the
 // result will contain a map[string][]int64.
 func (v *Value) GroupInt64(grouper func(int, int64) string) *Value {
-	groups := make(map[string][]int64)
-	v.EachInt64(func(index int, val int64) bool {
-		group := grouper(index, val)
-		if _, ok := groups[group]; !ok {
-			groups[group] = make([]int64, 0)
-		}
-		groups[group] = append(groups[group], val)
-		return true
-	})
-	return &Value{data: groups}
+	return groupOf(v, grouper)
 }
 
 // ReplaceInt64 uses the specified function to replace each int64s
 // by iterating each item.  The data in the returned result will be a
 // []int64 containing the replaced items.
 func (v *Value) ReplaceInt64(replacer func(int, int64) int64) *Value {
-	arr := v.MustInt64Slice()
-	replaced := make([]int64, len(arr))
-	v.EachInt64(func(index int, val int64) bool {
-		replaced[index] = replacer(index, val)
-		return true
-	})
-	return &Value{data: replaced}
+	return replaceOf(v, replacer)
 }
 
 // CollectInt64 uses the specified collector function to collect a value
 // for each of the int64s in the slice.  The data returned will be a
 // []interface{}.
 func (v *Value) CollectInt64(collector func(int, int64) interface{}) *Value {
-	arr := v.MustInt64Slice()
-	collected := make([]interface{}, len(arr))
-	v.EachInt64(func(index int, val int64) bool {
-		collected[index] = collector(index, val)
-		return true
-	})
-	return &Value{data: collected}
+	return collectOf(v, collector)
 }
 
 /*
@@ -1017,122 +641,75 @@ func (v *Value) CollectInt64(collector func(int, int64) interface{}) *Value {
 // Uint gets the value as a uint, returns the optionalDefault
 // value or a system default object if the value is the wrong type.
 func (v *Value) Uint(optionalDefault ...uint) uint {
-	if s, ok := v.data.(uint); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return 0
+	return typedOr(v, optionalDefault)
 }
 
 // MustUint gets the value as a uint.
 //
 // Panics if the object is not a uint.
 func (v *Value) MustUint() uint {
-	return v.data.(uint)
+	return mustTyped[uint](v)
 }
 
-// UintSlice gets the value as a []uint, returns the optionalDefault
-// value or nil if the value is not a []uint.
+// UintSlice gets the value as a []uint, converting the elements of
+// any other slice like As does. Returns the optionalDefault value or
+// nil if the value is not a slice or an element cannot be converted.
 func (v *Value) UintSlice(optionalDefault ...[]uint) []uint {
-	if s, ok := v.data.([]uint); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return nil
+	return sliceOr(v, optionalDefault)
 }
 
-// MustUintSlice gets the value as a []uint.
+// MustUintSlice gets the value as a []uint, like UintSlice.
 //
-// Panics if the object is not a []uint.
+// Panics if the object is not a slice or an element cannot be converted.
 func (v *Value) MustUintSlice() []uint {
-	return v.data.([]uint)
+	return mustSliceOf[uint](v)
 }
 
-// IsUint gets whether the object contained is a uint or not.
+// IsUint gets whether the object contained is a uint, or a
+// json.Number that Uint gets as one, or not.
 func (v *Value) IsUint() bool {
-	_, ok := v.data.(uint)
-	return ok
+	return isTyped[uint](v)
 }
 
 // IsUintSlice gets whether the object contained is a []uint or not.
 func (v *Value) IsUintSlice() bool {
-	_, ok := v.data.([]uint)
-	return ok
+	return isType[[]uint](v)
 }
 
 // EachUint calls the specified callback for each object
 // in the []uint.
 //
-// Panics if the object is the wrong type.
+// Panics if the object cannot be converted to a []uint.
 func (v *Value) EachUint(callback func(int, uint) bool) *Value {
-	for index, val := range v.MustUintSlice() {
-		carryon := callback(index, val)
-		if !carryon {
-			break
-		}
-	}
-	return v
+	return eachOf(v, callback)
 }
 
 // WhereUint uses the specified decider function to select items
 // from the []uint.  The object contained in the result will contain
 // only the selected items.
 func (v *Value) WhereUint(decider func(int, uint) bool) *Value {
-	var selected []uint
-	v.EachUint(func(index int, val uint) bool {
-		shouldSelect := decider(index, val)
-		if !shouldSelect {
-			selected = append(selected, val)
-		}
-		return true
-	})
-	return &Value{data: selected}
+	return whereOf(v, decider)
 }
 
 // GroupUint uses the specified grouper function to group the items
 // keyed by the return of the grouper.  The object contained in the
 // result will contain a map[string][]uint.
 func (v *Value) GroupUint(grouper func(int, uint) string) *Value {
-	groups := make(map[string][]uint)
-	v.EachUint(func(index int, val uint) bool {
-		group := grouper(index, val)
-		if _, ok := groups[group]; !ok {
-			groups[group] = make([]uint, 0)
-		}
-		groups[group] = append(groups[group], val)
-		return true
-	})
-	return &Value{data: groups}
+	return groupOf(v, grouper)
 }
 
 // ReplaceUint uses the specified function to replace each uints
 // by iterating each item.  The data in the returned result will be a
 // []uint containing the replaced items.
 func (v *Value) ReplaceUint(replacer func(int, uint) uint) *Value {
-	arr := v.MustUintSlice()
-	replaced := make([]uint, len(arr))
-	v.EachUint(func(index int, val uint) bool {
-		replaced[index] = replacer(index, val)
-		return true
-	})
-	return &Value{data: replaced}
+	return replaceOf(v, replacer)
 }
 
 // CollectUint uses the specified collector function to collect a value
 // for each of the uints in the slice.  The data returned will be a
 // []interface{}.
 func (v *Value) CollectUint(collector func(int, uint) interface{}) *Value {
-	arr := v.MustUintSlice()
-	collected := make([]interface{}, len(arr))
-	v.EachUint(func(index int, val uint) bool {
-		collected[index] = collector(index, val)
-		return true
-	})
-	return &Value{data: collected}
+	return collectOf(v, collector)
 }
 
 /*
@@ -1142,122 +719,75 @@ func (v *Value) CollectUint(collector func(int, uint) interface{}) *Value {
 // Uint8 gets the value as a uint8, returns the optionalDefault
 // value or a system default object if the value is the wrong type.
 func (v *Value) Uint8(optionalDefault ...uint8) uint8 {
-	if s, ok := v.data.(uint8); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return 0
+	return typedOr(v, optionalDefault)
 }
 
 // MustUint8 gets the value as a uint8.
 //
 // Panics if the object is not a uint8.
 func (v *Value) MustUint8() uint8 {
-	return v.data.(uint8)
+	return mustTyped[uint8](v)
 }
 
-// Uint8Slice gets the value as a []uint8, returns the optionalDefault
-// value or nil if the value is not a []uint8.
+// Uint8Slice gets the value as a []uint8, converting the elements of
+// any other slice like As does. Returns the optionalDefault value or
+// nil if the value is not a slice or an element cannot be converted.
 func (v *Value) Uint8Slice(optionalDefault ...[]uint8) []uint8 {
-	if s, ok := v.data.([]uint8); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return nil
+	return sliceOr(v, optionalDefault)
 }
 
-// MustUint8Slice gets the value as a []uint8.
+// MustUint8Slice gets the value as a []uint8, like Uint8Slice.
 //
-// Panics if the object is not a []uint8.
+// Panics if the object is not a slice or an element cannot be converted.
 func (v *Value) MustUint8Slice() []uint8 {
-	return v.data.([]uint8)
+	return mustSliceOf[uint8](v)
 }
 
-// IsUint8 gets whether the object contained is a uint8 or not.
+// IsUint8 gets whether the object contained is a uint8, or a
+// json.Number that Uint8 gets as one, or not.
 func (v *Value) IsUint8() bool {
-	_, ok := v.data.(uint8)
-	return ok
+	return isTyped[uint8](v)
 }
 
 // IsUint8Slice gets whether the object contained is a []uint8 or not.
 func (v *Value) IsUint8Slice() bool {
-	_, ok := v.data.([]uint8)
-	return ok
+	return isType[[]uint8](v)
 }
 
 // EachUint8 calls the specified callback for each object
 // in the []uint8.
 //
-// Panics if the object is the wrong type.
+// Panics if the object cannot be converted to a []uint8.
 func (v *Value) EachUint8(callback func(int, uint8) bool) *Value {
-	for index, val := range v.MustUint8Slice() {
-		carryon := callback(index, val)
-		if !carryon {
-			break
-		}
-	}
-	return v
+	return eachOf(v, callback)
 }
 
 // WhereUint8 uses the specified decider function to select items
 // from the []uint8.  The object contained in the result will contain
 // only the selected items.
 func (v *Value) WhereUint8(decider func(int, uint8) bool) *Value {
-	var selected []uint8
-	v.EachUint8(func(index int, val uint8) bool {
-		shouldSelect := decider(index, val)
-		if !shouldSelect {
-			selected = append(selected, val)
-		}
-		return true
-	})
-	return &Value{data: selected}
+	return whereOf(v, decider)
 }
 
 // GroupUint8 uses the specified grouper function to group the items
 // keyed by the return of the grouper.  The object contained in the
 // result will contain a map[string][]uint8.
 func (v *Value) GroupUint8(grouper func(int, uint8) string) *Value {
-	groups := make(map[string][]uint8)
-	v.EachUint8(func(index int, val uint8) bool {
-		group := grouper(index, val)
-		if _, ok := groups[group]; !ok {
-			groups[group] = make([]uint8, 0)
-		}
-		groups[group] = append(groups[group], val)
-		return true
-	})
-	return &Value{data: groups}
+	return groupOf(v, grouper)
 }
 
 // ReplaceUint8 uses the specified function to replace each uint8s
 // by iterating each item.  The data in the returned result will be a
 // []uint8 containing the replaced items.
 func (v *Value) ReplaceUint8(replacer func(int, uint8) uint8) *Value {
-	arr := v.MustUint8Slice()
-	replaced := make([]uint8, len(arr))
-	v.EachUint8(func(index int, val uint8) bool {
-		replaced[index] = replacer(index, val)
-		return true
-	})
-	return &Value{data: replaced}
+	return replaceOf(v, replacer)
 }
 
 // CollectUint8 uses the specified collector function to collect a value
 // for each of the uint8s in the slice.  The data returned will be a
 // []interface{}.
 func (v *Value) CollectUint8(collector func(int, uint8) interface{}) *Value {
-	arr := v.MustUint8Slice()
-	collected := make([]interface{}, len(arr))
-	v.EachUint8(func(index int, val uint8) bool {
-		collected[index] = collector(index, val)
-		return true
-	})
-	return &Value{data: collected}
+	return collectOf(v, collector)
 }
 
 /*
@@ -1267,122 +797,75 @@ func (v *Value) CollectUint8(collector func(int, uint8) interface{}) *Value {
 // Uint16 gets the value as a uint16, returns the optionalDefault
 // value or a system default object if the value is the wrong type.
 func (v *Value) Uint16(optionalDefault ...uint16) uint16 {
-	if s, ok := v.data.(uint16); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return 0
+	return typedOr(v, optionalDefault)
 }
 
 // MustUint16 gets the value as a uint16.
 //
 // Panics if the object is not a uint16.
 func (v *Value) MustUint16() uint16 {
-	return v.data.(uint16)
+	return mustTyped[uint16](v)
 }
 
-// Uint16Slice gets the value as a []uint16, returns the optionalDefault
-// value or nil if the value is not a []uint16.
+// Uint16Slice gets the value as a []uint16, converting the elements of
+// any other slice like As does. Returns the optionalDefault value or
+// nil if the value is not a slice or an element cannot be converted.
 func (v *Value) Uint16Slice(optionalDefault ...[]uint16) []uint16 {
-	if s, ok := v.data.([]uint16); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return nil
+	return sliceOr(v, optionalDefault)
 }
 
-// MustUint16Slice gets the value as a []uint16.
+// MustUint16Slice gets the value as a []uint16, like Uint16Slice.
 //
-// Panics if the object is not a []uint16.
+// Panics if the object is not a slice or an element cannot be converted.
 func (v *Value) MustUint16Slice() []uint16 {
-	return v.data.([]uint16)
+	return mustSliceOf[uint16](v)
 }
 
-// IsUint16 gets whether the object contained is a uint16 or not.
+// IsUint16 gets whether the object contained is a uint16, or a
+// json.Number that Uint16 gets as one, or not.
 func (v *Value) IsUint16() bool {
-	_, ok := v.data.(uint16)
-	return ok
+	return isTyped[uint16](v)
 }
 
 // IsUint16Slice gets whether the object contained is a []uint16 or not.
 func (v *Value) IsUint16Slice() bool {
-	_, ok := v.data.([]uint16)
-	return ok
+	return isType[[]uint16](v)
 }
 
 // EachUint16 calls the specified callback for each object
 // in the []uint16.
 //
-// Panics if the object is the wrong type.
+// Panics if the object cannot be converted to a []uint16.
 func (v *Value) EachUint16(callback func(int, uint16) bool) *Value {
-	for index, val := range v.MustUint16Slice() {
-		carryon := callback(index, val)
-		if !carryon {
-			break
-		}
-	}
-	return v
+	return eachOf(v, callback)
 }
 
 // WhereUint16 uses the specified decider function to select items
 // from the []uint16.  The object contained in the result will contain
 // only the selected items.
 func (v *Value) WhereUint16(decider func(int, uint16) bool) *Value {
-	var selected []uint16
-	v.EachUint16(func(index int, val uint16) bool {
-		shouldSelect := decider(index, val)
-		if !shouldSelect {
-			selected = append(selected, val)
-		}
-		return true
-	})
-	return &Value{data: selected}
+	return whereOf(v, decider)
 }
 
 // GroupUint16 uses the specified grouper function to group the items
 // keyed by the return of the grouper.  The object contained in the
 // result will contain a map[string][]uint16.
 func (v *Value) GroupUint16(grouper func(int, uint16) string) *Value {
-	groups := make(map[string][]uint16)
-	v.EachUint16(func(index int, val uint16) bool {
-		group := grouper(index, val)
-		if _, ok := groups[group]; !ok {
-			groups[group] = make([]uint16, 0)
-		}
-		groups[group] = append(groups[group], val)
-		return true
-	})
-	return &Value{data: groups}
+	return groupOf(v, grouper)
 }
 
 // ReplaceUint16 uses the specified function to replace each uint16s
 // by iterating each item.  The data in the returned result will be a
 // []uint16 containing the replaced items.
 func (v *Value) ReplaceUint16(replacer func(int, uint16) uint16) *Value {
-	arr := v.MustUint16Slice()
-	replaced := make([]uint16, len(arr))
-	v.EachUint16(func(index int, val uint16) bool {
-		replaced[index] = replacer(index, val)
-		return true
-	})
-	return &Value{data: replaced}
+	return replaceOf(v, replacer)
 }
 
 // CollectUint16 uses the specified collector function to collect a value
 // for each of the uint16s in the slice.  The data returned will be a
 // []interface{}.
 func (v *Value) CollectUint16(collector func(int, uint16) interface{}) *Value {
-	arr := v.MustUint16Slice()
-	collected := make([]interface{}, len(arr))
-	v.EachUint16(func(index int, val uint16) bool {
-		collected[index] = collector(index, val)
-		return true
-	})
-	return &Value{data: collected}
+	return collectOf(v, collector)
 }
 
 /*
@@ -1392,122 +875,75 @@ func (v *Value) CollectUint16(collector func(int, uint16) interface{}) *Value {
 // Uint32 gets the value as a uint32, returns the optionalDefault
 // value or a system default object if the value is the wrong type.
 func (v *Value) Uint32(optionalDefault ...uint32) uint32 {
-	if s, ok := v.data.(uint32); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return 0
+	return typedOr(v, optionalDefault)
 }
 
 // MustUint32 gets the value as a uint32.
 //
 // Panics if the object is not a uint32.
 func (v *Value) MustUint32() uint32 {
-	return v.data.(uint32)
+	return mustTyped[uint32](v)
 }
 
-// Uint32Slice gets the value as a []uint32, returns the optionalDefault
-// value or nil if the value is not a []uint32.
+// Uint32Slice gets the value as a []uint32, converting the elements of
+// any other slice like As does. Returns the optionalDefault value or
+// nil if the value is not a slice or an element cannot be converted.
 func (v *Value) Uint32Slice(optionalDefault ...[]uint32) []uint32 {
-	if s, ok := v.data.([]uint32); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return nil
+	return sliceOr(v, optionalDefault)
 }
 
-// MustUint32Slice gets the value as a []uint32.
+// MustUint32Slice gets the value as a []uint32, like Uint32Slice.
 //
-// Panics if the object is not a []uint32.
+// Panics if the object is not a slice or an element cannot be converted.
 func (v *Value) MustUint32Slice() []uint32 {
-	return v.data.([]uint32)
+	return mustSliceOf[uint32](v)
 }
 
-// IsUint32 gets whether the object contained is a uint32 or not.
+// IsUint32 gets whether the object contained is a uint32, or a
+// json.Number that Uint32 gets as one, or not.
 func (v *Value) IsUint32() bool {
-	_, ok := v.data.(uint32)
-	return ok
+	return isTyped[uint32](v)
 }
 
 // IsUint32Slice gets whether the object contained is a []uint32 or not.
 func (v *Value) IsUint32Slice() bool {
-	_, ok := v.data.([]uint32)
-	return ok
+	return isType[[]uint32](v)
 }
 
 // EachUint32 calls the specified callback for each object
 // in the []uint32.
 //
-// Panics if the object is the wrong type.
+// Panics if the object cannot be converted to a []uint32.
 func (v *Value) EachUint32(callback func(int, uint32) bool) *Value {
-	for index, val := range v.MustUint32Slice() {
-		carryon := callback(index, val)
-		if !carryon {
-			break
-		}
-	}
-	return v
+	return eachOf(v, callback)
 }
 
 // WhereUint32 uses the specified decider function to select items
 // from the []uint32.  The object contained in the result will contain
 // only the selected items.
 func (v *Value) WhereUint32(decider func(int, uint32) bool) *Value {
-	var selected []uint32
-	v.EachUint32(func(index int, val uint32) bool {
-		shouldSelect := decider(index, val)
-		if !shouldSelect {
-			selected = append(selected, val)
-		}
-		return true
-	})
-	return &Value{data: selected}
+	return whereOf(v, decider)
 }
 
 // GroupUint32 uses the specified grouper function to group the items
 // keyed by the return of the grouper.  The object contained in the
 // result will contain a map[string][]uint32.
 func (v *Value) GroupUint32(grouper func(int, uint32) string) *Value {
-	groups := make(map[string][]uint32)
-	v.EachUint32(func(index int, val uint32) bool {
-		group := grouper(index, val)
-		if _, ok := groups[group]; !ok {
-			groups[group] = make([]uint32, 0)
-		}
-		groups[group] = append(groups[group], val)
-		return true
-	})
-	return &Value{data: groups}
+	return groupOf(v, grouper)
 }
 
 // ReplaceUint32 uses the specified function to replace each uint32s
 // by iterating each item.  The data in the returned result will be a
 // []uint32 containing the replaced items.
 func (v *Value) ReplaceUint32(replacer func(int, uint32) uint32) *Value {
-	arr := v.MustUint32Slice()
-	replaced := make([]uint32, len(arr))
-	v.EachUint32(func(index int, val uint32) bool {
-		replaced[index] = replacer(index, val)
-		return true
-	})
-	return &Value{data: replaced}
+	return replaceOf(v, replacer)
 }
 
 // CollectUint32 uses the specified collector function to collect a value
 // for each of the uint32s in the slice.  The data returned will be a
 // []interface{}.
 func (v *Value) CollectUint32(collector func(int, uint32) interface{}) *Value {
-	arr := v.MustUint32Slice()
-	collected := make([]interface{}, len(arr))
-	v.EachUint32(func(index int, val uint32) bool {
-		collected[index] = collector(index, val)
-		return true
-	})
-	return &Value{data: collected}
+	return collectOf(v, collector)
 }
 
 /*
@@ -1517,122 +953,75 @@ func (v *Value) CollectUint32(collector func(int, uint32) interface{}) *Value {
 // Uint64 gets the value as a uint64, returns the optionalDefault
 // value or a system default object if the value is the wrong type.
 func (v *Value) Uint64(optionalDefault ...uint64) uint64 {
-	if s, ok := v.data.(uint64); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return 0
+	return typedOr(v, optionalDefault)
 }
 
 // MustUint64 gets the value as a uint64.
 //
 // Panics if the object is not a uint64.
 func (v *Value) MustUint64() uint64 {
-	return v.data.(uint64)
+	return mustTyped[uint64](v)
 }
 
-// Uint64Slice gets the value as a []uint64, returns the optionalDefault
-// value or nil if the value is not a []uint64.
+// Uint64Slice gets the value as a []uint64, converting the elements of
+// any other slice like As does. Returns the optionalDefault value or
+// nil if the value is not a slice or an element cannot be converted.
 func (v *Value) Uint64Slice(optionalDefault ...[]uint64) []uint64 {
-	if s, ok := v.data.([]uint64); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return nil
+	return sliceOr(v, optionalDefault)
 }
 
-// MustUint64Slice gets the value as a []uint64.
+// MustUint64Slice gets the value as a []uint64, like Uint64Slice.
 //
-// Panics if the object is not a []uint64.
+// Panics if the object is not a slice or an element cannot be converted.
 func (v *Value) MustUint64Slice() []uint64 {
-	return v.data.([]uint64)
+	return mustSliceOf[uint64](v)
 }
 
-// IsUint64 gets whether the object contained is a uint64 or not.
+// IsUint64 gets whether the object contained is a uint64, or a
+// json.Number that Uint64 gets as one, or not.
 func (v *Value) IsUint64() bool {
-	_, ok := v.data.(uint64)
-	return ok
+	return isTyped[uint64](v)
 }
 
 // IsUint64Slice gets whether the object contained is a []uint64 or not.
 func (v *Value) IsUint64Slice() bool {
-	_, ok := v.data.([]uint64)
-	return ok
+	return isType[[]uint64](v)
 }
 
 // EachUint64 calls the specified callback for each object
 // in the []uint64.
 //
-// Panics if the object is the wrong type.
+// Panics if the object cannot be converted to a []uint64.
 func (v *Value) EachUint64(callback func(int, uint64) bool) *Value {
-	for index, val := range v.MustUint64Slice() {
-		carryon := callback(index, val)
-		if !carryon {
-			break
-		}
-	}
-	return v
+	return eachOf(v, callback)
 }
 
 // WhereUint64 uses the specified decider function to select items
 // from the []uint64.  The object contained in the result will contain
 // only the selected items.
 func (v *Value) WhereUint64(decider func(int, uint64) bool) *Value {
-	var selected []uint64
-	v.EachUint64(func(index int, val uint64) bool {
-		shouldSelect := decider(index, val)
-		if !shouldSelect {
-			selected = append(selected, val)
-		}
-		return true
-	})
-	return &Value{data: selected}
+	return whereOf(v, decider)
 }
 
 // GroupUint64 uses the specified grouper function to group the items
 // keyed by the return of the grouper.  The object contained in the
 // result will contain a map[string][]uint64.
 func (v *Value) GroupUint64(grouper func(int, uint64) string) *Value {
-	groups := make(map[string][]uint64)
-	v.EachUint64(func(index int, val uint64) bool {
-		group := grouper(index, val)
-		if _, ok := groups[group]; !ok {
-			groups[group] = make([]uint64, 0)
-		}
-		groups[group] = append(groups[group], val)
-		return true
-	})
-	return &Value{data: groups}
+	return groupOf(v, grouper)
 }
 
 // ReplaceUint64 uses the specified function to replace each uint64s
 // by iterating each item.  The data in the returned result will be a
 // []uint64 containing the replaced items.
 func (v *Value) ReplaceUint64(replacer func(int, uint64) uint64) *Value {
-	arr := v.MustUint64Slice()
-	replaced := make([]uint64, len(arr))
-	v.EachUint64(func(index int, val uint64) bool {
-		replaced[index] = replacer(index, val)
-		return true
-	})
-	return &Value{data: replaced}
+	return replaceOf(v, replacer)
 }
 
 // CollectUint64 uses the specified collector function to collect a value
 // for each of the uint64s in the slice.  The data returned will be a
 // []interface{}.
 func (v *Value) CollectUint64(collector func(int, uint64) interface{}) *Value {
-	arr := v.MustUint64Slice()
-	collected := make([]interface{}, len(arr))
-	v.EachUint64(func(index int, val uint64) bool {
-		collected[index] = collector(index, val)
-		return true
-	})
-	return &Value{data: collected}
+	return collectOf(v, collector)
 }
 
 /*
@@ -1642,122 +1031,75 @@ func (v *Value) CollectUint64(collector func(int, uint64) interface{}) *Value {
 // Uintptr gets the value as a uintptr, returns the optionalDefault
 // value or a system default object if the value is the wrong type.
 func (v *Value) Uintptr(optionalDefault ...uintptr) uintptr {
-	if s, ok := v.data.(uintptr); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return 0
+	return typedOr(v, optionalDefault)
 }
 
 // MustUintptr gets the value as a uintptr.
 //
 // Panics if the object is not a uintptr.
 func (v *Value) MustUintptr() uintptr {
-	return v.data.(uintptr)
+	return mustTyped[uintptr](v)
 }
 
-// UintptrSlice gets the value as a []uintptr, returns the optionalDefault
-// value or nil if the value is not a []uintptr.
+// UintptrSlice gets the value as a []uintptr, converting the elements of
+// any other slice like As does. Returns the optionalDefault value or
+// nil if the value is not a slice or an element cannot be converted.
 func (v *Value) UintptrSlice(optionalDefault ...[]uintptr) []uintptr {
-	if s, ok := v.data.([]uintptr); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return nil
+	return sliceOr(v, optionalDefault)
 }
 
-// MustUintptrSlice gets the value as a []uintptr.
+// MustUintptrSlice gets the value as a []uintptr, like UintptrSlice.
 //
-// Panics if the object is not a []uintptr.
+// Panics if the object is not a slice or an element cannot be converted.
 func (v *Value) MustUintptrSlice() []uintptr {
-	return v.data.([]uintptr)
+	return mustSliceOf[uintptr](v)
 }
 
-// IsUintptr gets whether the object contained is a uintptr or not.
+// IsUintptr gets whether the object contained is a uintptr, or a
+// json.Number that Uintptr gets as one, or not.
 func (v *Value) IsUintptr() bool {
-	_, ok := v.data.(uintptr)
-	return ok
+	return isTyped[uintptr](v)
 }
 
 // IsUintptrSlice gets whether the object contained is a []uintptr or not.
 func (v *Value) IsUintptrSlice() bool {
-	_, ok := v.data.([]uintptr)
-	return ok
+	return isType[[]uintptr](v)
 }
 
 // EachUintptr calls the specified callback for each object
 // in the []uintptr.
 //
-// Panics if the object is the wrong type.
+// Panics if the object cannot be converted to a []uintptr.
 func (v *Value) EachUintptr(callback func(int, uintptr) bool) *Value {
-	for index, val := range v.MustUintptrSlice() {
-		carryon := callback(index, val)
-		if !carryon {
-			break
-		}
-	}
-	return v
+	return eachOf(v, callback)
 }
 
 // WhereUintptr uses the specified decider function to select items
 // from the []uintptr.  The object contained in the result will contain
 // only the selected items.
 func (v *Value) WhereUintptr(decider func(int, uintptr) bool) *Value {
-	var selected []uintptr
-	v.EachUintptr(func(index int, val uintptr) bool {
-		shouldSelect := decider(index, val)
-		if !shouldSelect {
-			selected = append(selected, val)
-		}
-		return true
-	})
-	return &Value{data: selected}
+	return whereOf(v, decider)
 }
 
 // GroupUintptr uses the specified grouper function to group the items
 // keyed by the return of the grouper.  The object contained in the
 // result will contain a map[string][]uintptr.
 func (v *Value) GroupUintptr(grouper func(int, uintptr) string) *Value {
-	groups := make(map[string][]uintptr)
-	v.EachUintptr(func(index int, val uintptr) bool {
-		group := grouper(index, val)
-		if _, ok := groups[group]; !ok {
-			groups[group] = make([]uintptr, 0)
-		}
-		groups[group] = append(groups[group], val)
-		return true
-	})
-	return &Value{data: groups}
+	return groupOf(v, grouper)
 }
 
 // ReplaceUintptr uses the specified function to replace each uintptrs
 // by iterating each item.  The data in the returned result will be a
 // []uintptr containing the replaced items.
 func (v *Value) ReplaceUintptr(replacer func(int, uintptr) uintptr) *Value {
-	arr := v.MustUintptrSlice()
-	replaced := make([]uintptr, len(arr))
-	v.EachUintptr(func(index int, val uintptr) bool {
-		replaced[index] = replacer(index, val)
-		return true
-	})
-	return &Value{data: replaced}
+	return replaceOf(v, replacer)
 }
 
 // CollectUintptr uses the specified collector function to collect a value
 // for each of the uintptrs in the slice.  The data returned will be a
 // []interface{}.
 func (v *Value) CollectUintptr(collector func(int, uintptr) interface{}) *Value {
-	arr := v.MustUintptrSlice()
-	collected := make([]interface{}, len(arr))
-	v.EachUintptr(func(index int, val uintptr) bool {
-		collected[index] = collector(index, val)
-		return true
-	})
-	return &Value{data: collected}
+	return collectOf(v, collector)
 }
 
 /*
@@ -1767,122 +1109,75 @@ func (v *Value) CollectUintptr(collector func(int, uintptr) interface{}) *Value
 // Float32 gets the value as a float32, returns the optionalDefault
 // value or a system default object if the value is the wrong type.
 func (v *Value) Float32(optionalDefault ...float32) float32 {
-	if s, ok := v.data.(float32); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return 0
+	return typedOr(v, optionalDefault)
 }
 
 // MustFloat32 gets the value as a float32.
 //
 // Panics if the object is not a float32.
 func (v *Value) MustFloat32() float32 {
-	return v.data.(float32)
+	return mustTyped[float32](v)
 }
 
-// Float32Slice gets the value as a []float32, returns the optionalDefault
-// value or nil if the value is not a []float32.
+// Float32Slice gets the value as a []float32, converting the elements of
+// any other slice like As does. Returns the optionalDefault value or
+// nil if the value is not a slice or an element cannot be converted.
 func (v *Value) Float32Slice(optionalDefault ...[]float32) []float32 {
-	if s, ok := v.data.([]float32); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return nil
+	return sliceOr(v, optionalDefault)
 }
 
-// MustFloat32Slice gets the value as a []float32.
+// MustFloat32Slice gets the value as a []float32, like Float32Slice.
 //
-// Panics if the object is not a []float32.
+// Panics if the object is not a slice or an element cannot be converted.
 func (v *Value) MustFloat32Slice() []float32 {
-	return v.data.([]float32)
+	return mustSliceOf[float32](v)
 }
 
-// IsFloat32 gets whether the object contained is a float32 or not.
+// IsFloat32 gets whether the object contained is a float32, or a
+// json.Number that Float32 gets as one, or not.
 func (v *Value) IsFloat32() bool {
-	_, ok := v.data.(float32)
-	return ok
+	return isTyped[float32](v)
 }
 
 // IsFloat32Slice gets whether the object contained is a []float32 or not.
 func (v *Value) IsFloat32Slice() bool {
-	_, ok := v.data.([]float32)
-	return ok
+	return isType[[]float32](v)
 }
 
 // EachFloat32 calls the specified callback for each object
 // in the []float32.
 //
-// Panics if the object is the wrong type.
+// Panics if the object cannot be converted to a []float32.
 func (v *Value) EachFloat32(callback func(int, float32) bool) *Value {
-	for index, val := range v.MustFloat32Slice() {
-		carryon := callback(index, val)
-		if !carryon {
-			break
-		}
-	}
-	return v
+	return eachOf(v, callback)
 }
 
 // WhereFloat32 uses the specified decider function to select items
 // from the []float32.  The object contained in the result will contain
 // only the selected items.
 func (v *Value) WhereFloat32(decider func(int, float32) bool) *Value {
-	var selected []float32
-	v.EachFloat32(func(index int, val float32) bool {
-		shouldSelect := decider(index, val)
-		if !shouldSelect {
-			selected = append(selected, val)
-		}
-		return true
-	})
-	return &Value{data: selected}
+	return whereOf(v, decider)
 }
 
 // GroupFloat32 uses the specified grouper function to group the items
 // keyed by the return of the grouper.  The object contained in the
 // result will contain a map[string][]float32.
 func (v *Value) GroupFloat32(grouper func(int, float32) string) *Value {
-	groups := make(map[string][]float32)
-	v.EachFloat32(func(index int, val float32) bool {
-		group := grouper(index, val)
-		if _, ok := groups[group]; !ok {
-			groups[group] = make([]float32, 0)
-		}
-		groups[group] = append(groups[group], val)
-		return true
-	})
-	return &Value{data: groups}
+	return groupOf(v, grouper)
 }
 
 // ReplaceFloat32 uses the specified function to replace each float32s
 // by iterating each item.  The data in the returned result will be a
 // []float32 containing the replaced items.
 func (v *Value) ReplaceFloat32(replacer func(int, float32) float32) *Value {
-	arr := v.MustFloat32Slice()
-	replaced := make([]float32, len(arr))
-	v.EachFloat32(func(index int, val float32) bool {
-		replaced[index] = replacer(index, val)
-		return true
-	})
-	return &Value{data: replaced}
+	return replaceOf(v, replacer)
 }
 
 // CollectFloat32 uses the specified collector function to collect a value
 // for each of the float32s in the slice.  The data returned will be a
 // []interface{}.
 func (v *Value) CollectFloat32(collector func(int, float32) interface{}) *Value {
-	arr := v.MustFloat32Slice()
-	collected := make([]interface{}, len(arr))
-	v.EachFloat32(func(index int, val float32) bool {
-		collected[index] = collector(index, val)
-		return true
-	})
-	return &Value{data: collected}
+	return collectOf(v, collector)
 }
 
 /*
@@ -1892,122 +1187,75 @@ func (v *Value) CollectFloat32(collector func(int, float32) interface{}) *Value
 // Float64 gets the value as a float64, returns the optionalDefault
 // value or a system default object if the value is the wrong type.
 func (v *Value) Float64(optionalDefault ...float64) float64 {
-	if s, ok := v.data.(float64); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return 0
+	return typedOr(v, optionalDefault)
 }
 
 // MustFloat64 gets the value as a float64.
 //
 // Panics if the object is not a float64.
 func (v *Value) MustFloat64() float64 {
-	return v.data.(float64)
+	return mustTyped[float64](v)
 }
 
-// Float64Slice gets the value as a []float64, returns the optionalDefault
-// value or nil if the value is not a []float64.
+// Float64Slice gets the value as a []float64, converting the elements of
+// any other slice like As does. Returns the optionalDefault value or
+// nil if the value is not a slice or an element cannot be converted.
 func (v *Value) Float64Slice(optionalDefault ...[]float64) []float64 {
-	if s, ok := v.data.([]float64); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return nil
+	return sliceOr(v, optionalDefault)
 }
 
-// MustFloat64Slice gets the value as a []float64.
+// MustFloat64Slice gets the value as a []float64, like Float64Slice.
 //
-// Panics if the object is not a []float64.
+// Panics if the object is not a slice or an element cannot be converted.
 func (v *Value) MustFloat64Slice() []float64 {
-	return v.data.([]float64)
+	return mustSliceOf[float64](v)
 }
 
-// IsFloat64 gets whether the object contained is a float64 or not.
+// IsFloat64 gets whether the object contained is a float64, or a
+// json.Number that Float64 gets as one, or not.
 func (v *Value) IsFloat64() bool {
-	_, ok := v.data.(float64)
-	return ok
+	return isTyped[float64](v)
 }
 
 // IsFloat64Slice gets whether the object contained is a []float64 or not.
 func (v *Value) IsFloat64Slice() bool {
-	_, ok := v.data.([]float64)
-	return ok
+	return isType[[]float64](v)
 }
 
 // EachFloat64 calls the specified callback for each object
 // in the []float64.
 //
-// Panics if the object is the wrong type.
+// Panics if the object cannot be converted to a []float64.
 func (v *Value) EachFloat64(callback func(int, float64) bool) *Value {
-	for index, val := range v.MustFloat64Slice() {
-		carryon := callback(index, val)
-		if !carryon {
-			break
-		}
-	}
-	return v
+	return eachOf(v, callback)
 }
 
 // WhereFloat64 uses the specified decider function to select items
 // from the []float64.  The object contained in the result will contain
 // only the selected items.
 func (v *Value) WhereFloat64(decider func(int, float64) bool) *Value {
-	var selected []float64
-	v.EachFloat64(func(index int, val float64) bool {
-		shouldSelect := decider(index, val)
-		if !shouldSelect {
-			selected = append(selected, val)
-		}
-		return true
-	})
-	return &Value{data: selected}
+	return whereOf(v, decider)
 }
 
 // GroupFloat64 uses the specified grouper function to group the items
 // keyed by the return of the grouper.  The object contained in the
 // result will contain a map[string][]float64.
 func (v *Value) GroupFloat64(grouper func(int, float64) string) *Value {
-	groups := make(map[string][]float64)
-	v.EachFloat64(func(index int, val float64) bool {
-		group := grouper(index, val)
-		if _, ok := groups[group]; !ok {
-			groups[group] = make([]float64, 0)
-		}
-		groups[group] = append(groups[group], val)
-		return true
-	})
-	return &Value{data: groups}
+	return groupOf(v, grouper)
 }
 
 // ReplaceFloat64 uses the specified function to replace each float64s
 // by iterating each item.  The data in the returned result will be a
 // []float64 containing the replaced items.
 func (v *Value) ReplaceFloat64(replacer func(int, float64) float64) *Value {
-	arr := v.MustFloat64Slice()
-	replaced := make([]float64, len(arr))
-	v.EachFloat64(func(index int, val float64) bool {
-		replaced[index] = replacer(index, val)
-		return true
-	})
-	return &Value{data: replaced}
+	return replaceOf(v, replacer)
 }
 
 // CollectFloat64 uses the specified collector function to collect a value
 // for each of the float64s in the slice.  The data returned will be a
 // []interface{}.
 func (v *Value) CollectFloat64(collector func(int, float64) interface{}) *Value {
-	arr := v.MustFloat64Slice()
-	collected := make([]interface{}, len(arr))
-	v.EachFloat64(func(index int, val float64) bool {
-		collected[index] = collector(index, val)
-		return true
-	})
-	return &Value{data: collected}
+	return collectOf(v, collector)
 }
 
 /*
@@ -2017,122 +1265,75 @@ func (v *Value) CollectFloat64(collector func(int, float64) interface{}) *Value
 // Complex64 gets the value as a complex64, returns the optionalDefault
 // value or a system default object if the value is the wrong type.
 func (v *Value) Complex64(optionalDefault ...complex64) complex64 {
-	if s, ok := v.data.(complex64); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return 0
+	return typedOr(v, optionalDefault)
 }
 
 // MustComplex64 gets the value as a complex64.
 //
 // Panics if the object is not a complex64.
 func (v *Value) MustComplex64() complex64 {
-	return v.data.(complex64)
+	return mustTyped[complex64](v)
 }
 
-// Complex64Slice gets the value as a []complex64, returns the optionalDefault
-// value or nil if the value is not a []complex64.
+// Complex64Slice gets the value as a []complex64, converting the elements of
+// any other slice like As does. Returns the optionalDefault value or
+// nil if the value is not a slice or an element cannot be converted.
 func (v *Value) Complex64Slice(optionalDefault ...[]complex64) []complex64 {
-	if s, ok := v.data.([]complex64); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return nil
+	return sliceOr(v, optionalDefault)
 }
 
-// MustComplex64Slice gets the value as a []complex64.
+// MustComplex64Slice gets the value as a []complex64, like Complex64Slice.
 //
-// Panics if the object is not a []complex64.
+// Panics if the object is not a slice or an element cannot be converted.
 func (v *Value) MustComplex64Slice() []complex64 {
-	return v.data.([]complex64)
+	return mustSliceOf[complex64](v)
 }
 
-// IsComplex64 gets whether the object contained is a complex64 or not.
+// IsComplex64 gets whether the object contained is a complex64, or a
+// json.Number that Complex64 gets as one, or not.
 func (v *Value) IsComplex64() bool {
-	_, ok := v.data.(complex64)
-	return ok
+	return isTyped[complex64](v)
 }
 
 // IsComplex64Slice gets whether the object contained is a []complex64 or not.
 func (v *Value) IsComplex64Slice() bool {
-	_, ok := v.data.([]complex64)
-	return ok
+	return isType[[]complex64](v)
 }
 
 // EachComplex64 calls the specified callback for each object
 // in the []complex64.
 //
-// Panics if the object is the wrong type.
+// Panics if the object cannot be converted to a []complex64.
 func (v *Value) EachComplex64(callback func(int, complex64) bool) *Value {
-	for index, val := range v.MustComplex64Slice() {
-		carryon := callback(index, val)
-		if !carryon {
-			break
-		}
-	}
-	return v
+	return eachOf(v, callback)
 }
 
 // WhereComplex64 uses the specified decider function to select items
 // from the []complex64.  The object contained in the result will contain
 // only the selected items.
 func (v *Value) WhereComplex64(decider func(int, complex64) bool) *Value {
-	var selected []complex64
-	v.EachComplex64(func(index int, val complex64) bool {
-		shouldSelect := decider(index, val)
-		if !shouldSelect {
-			selected = append(selected, val)
-		}
-		return true
-	})
-	return &Value{data: selected}
+	return whereOf(v, decider)
 }
 
 // GroupComplex64 uses the specified grouper function to group the items
 // keyed by the return of the grouper.  The object contained in the
 // result will contain a map[string][]complex64.
 func (v *Value) GroupComplex64(grouper func(int, complex64) string) *Value {
-	groups := make(map[string][]complex64)
-	v.EachComplex64(func(index int, val complex64) bool {
-		group := grouper(index, val)
-		if _, ok := groups[group]; !ok {
-			groups[group] = make([]complex64, 0)
-		}
-		groups[group] = append(groups[group], val)
-		return true
-	})
-	return &Value{data: groups}
+	return groupOf(v, grouper)
 }
 
 // ReplaceComplex64 uses the specified function to replace each complex64s
 // by iterating each item.  The data in the returned result will be a
 // []complex64 containing the replaced items.
 func (v *Value) ReplaceComplex64(replacer func(int, complex64) complex64) *Value {
-	arr := v.MustComplex64Slice()
-	replaced := make([]complex64, len(arr))
-	v.EachComplex64(func(index int, val complex64) bool {
-		replaced[index] = replacer(index, val)
-		return true
-	})
-	return &Value{data: replaced}
+	return replaceOf(v, replacer)
 }
 
 // CollectComplex64 uses the specified collector function to collect a value
 // for each of the complex64s in the slice.  The data returned will be a
 // []interface{}.
 func (v *Value) CollectComplex64(collector func(int, complex64) interface{}) *Value {
-	arr := v.MustComplex64Slice()
-	collected := make([]interface{}, len(arr))
-	v.EachComplex64(func(index int, val complex64) bool {
-		collected[index] = collector(index, val)
-		return true
-	})
-	return &Value{data: collected}
+	return collectOf(v, collector)
 }
 
 /*
@@ -2142,120 +1343,73 @@ func (v *Value) CollectComplex64(collector func(int, complex64) interface{}) *Va
 // Complex128 gets the value as a complex128, returns the optionalDefault
 // value or a system default object if the value is the wrong type.
 func (v *Value) Complex128(optionalDefault ...complex128) complex128 {
-	if s, ok := v.data.(complex128); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return 0
+	return typedOr(v, optionalDefault)
 }
 
 // MustComplex128 gets the value as a complex128.
 //
 // Panics if the object is not a complex128.
 func (v *Value) MustComplex128() complex128 {
-	return v.data.(complex128)
+	return mustTyped[complex128](v)
 }
 
-// Complex128Slice gets the value as a []complex128, returns the optionalDefault
-// value or nil if the value is not a []complex128.
+// Complex128Slice gets the value as a []complex128, converting the elements of
+// any other slice like As does. Returns the optionalDefault value or
+// nil if the value is not a slice or an element cannot be converted.
 func (v *Value) Complex128Slice(optionalDefault ...[]complex128) []complex128 {
-	if s, ok := v.data.([]complex128); ok {
-		return s
-	}
-	if len(optionalDefault) == 1 {
-		return optionalDefault[0]
-	}
-	return nil
+	return sliceOr(v, optionalDefault)
 }
 
-// MustComplex128Slice gets the value as a []complex128.
+// MustComplex128Slice gets the value as a []complex128, like Complex128Slice.
 //
-// Panics if the object is not a []complex128.
+// Panics if the object is not a slice or an element cannot be converted.
 func (v *Value) MustComplex128Slice() []complex128 {
-	return v.data.([]complex128)
+	return mustSliceOf[complex128](v)
 }
 
-// IsComplex128 gets whether the object contained is a complex128 or not.
+// IsComplex128 gets whether the object contained is a complex128, or a
+// json.Number that Complex128 gets as one, or not.
 func (v *Value) IsComplex128() bool {
-	_, ok := v.data.(complex128)
-	return ok
+	return isTyped[complex128](v)
 }
 
 // IsComplex128Slice gets whether the object contained is a []complex128 or not.
 func (v *Value) IsComplex128Slice() bool {
-	_, ok := v.data.([]complex128)
-	return ok
+	return isType[[]complex128](v)
 }
 
 // EachComplex128 calls the specified callback for each object
 // in the []complex128.
 //
-// Panics if the object is the wrong type.
+// Panics if the object cannot be converted to a []complex128.
 func (v *Value) EachComplex128(callback func(int, complex128) bool) *Value {
-	for index, val := range v.MustComplex128Slice() {
-		carryon := callback(index, val)
-		if !carryon {
-			break
-		}
-	}
-	return v
+	return eachOf(v, callback)
 }
 
 // WhereComplex128 uses the specified decider function to select items
 // from the []complex128.  The object contained in the result will contain
 // only the selected items.
 func (v *Value) WhereComplex128(decider func(int, complex128) bool) *Value {
-	var selected []complex128
-	v.EachComplex128(func(index int, val complex128) bool {
-		shouldSelect := decider(index, val)
-		if !shouldSelect {
-			selected = append(selected, val)
-		}
-		return true
-	})
-	return &Value{data: selected}
+	return whereOf(v, decider)
 }
 
 // GroupComplex128 uses the specified grouper function to group the items
 // keyed by the return of the grouper.  The object contained in the
 // result will contain a map[string][]complex128.
 func (v *Value) GroupComplex128(grouper func(int, complex128) string) *Value {
-	groups := make(map[string][]complex128)
-	v.EachComplex128(func(index int, val complex128) bool {
-		group := grouper(index, val)
-		if _, ok := groups[group]; !ok {
-			groups[group] = make([]complex128, 0)
-		}
-		groups[group] = append(groups[group], val)
-		return true
-	})
-	return &Value{data: groups}
+	return groupOf(v, grouper)
 }
 
 // ReplaceComplex128 uses the specified function to replace each complex128s
 // by iterating each item.  The data in the returned result will be a
 // []complex128 containing the replaced items.
 func (v *Value) ReplaceComplex128(replacer func(int, complex128) complex128) *Value {
-	arr := v.MustComplex128Slice()
-	replaced := make([]complex128, len(arr))
-	v.EachComplex128(func(index int, val complex128) bool {
-		replaced[index] = replacer(index, val)
-		return true
-	})
-	return &Value{data: replaced}
+	return replaceOf(v, replacer)
 }
 
 // CollectComplex128 uses the specified collector function to collect a value
 // for each of the complex128s in the slice.  The data returned will be a
 // []interface{}.
 func (v *Value) CollectComplex128(collector func(int, complex128) interface{}) *Value {
-	arr := v.MustComplex128Slice()
-	collected := make([]interface{}, len(arr))
-	v.EachComplex128(func(index int, val complex128) bool {
-		collected[index] = collector(index, val)
-		return true
-	})
-	return &Value{data: collected}
+	return collectOf(v, collector)
 }
@@ -1,8 +1,10 @@
 package objx
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
+	"time"
 )
 
 // Value provides methods for extracting interface{} data in various
@@ -10,6 +12,11 @@ import (
 type Value struct {
 	// data contains the raw data being managed by this Value
 	data interface{}
+	// path is the normalized path of the data when it was selected
+	// by a JSONPath query
+	path string
+	// missing is whether nothing was found where the data was looked for
+	missing bool
 }
 
 // Data returns the raw data contained by this Value
@@ -17,13 +24,48 @@ func (v *Value) Data() interface{} {
 	return v.data
 }
 
-// String returns the value always as a string
+// Path returns the RFC 9535 normalized path of the data when the Value
+// was returned by Query, or an empty string otherwise.
+func (v *Value) Path() string {
+	return v.path
+}
+
+// IsPresent gets whether the Value holds something that was found, even
+// if it is an explicit null. It is false when the Value was returned for
+// a missing key or an index out of range.
+//
+//	m := objx.MustFromJSON(`{"a": null}`)
+//	m.Get("a").IsPresent() // true
+//	m.Get("b").IsPresent() // false
+func (v *Value) IsPresent() bool {
+	return v != nil && !v.missing
+}
+
+// IsNull gets whether the Value holds an explicit null, as opposed to
+// nothing at all.
+//
+//	m := objx.MustFromJSON(`{"a": null}`)
+//	m.Get("a").IsNull() // true
+//	m.Get("b").IsNull() // false
+func (v *Value) IsNull() bool {
+	return v.IsPresent() && v.data == nil
+}
+
+// String returns the value always as a string. Times are formatted
+// with time.RFC3339Nano and durations like "1m30s".
 func (v *Value) String() string {
+	if n, ok := v.data.(json.Number); ok {
+		return n.String()
+	}
 	switch {
 	case v.IsNil():
 		return ""
 	case v.IsStr():
 		return v.Str()
+	case v.IsTime():
+		return v.MustTime().Format(time.RFC3339Nano)
+	case v.IsDuration():
+		return v.MustDuration().String()
 	case v.IsBool():
 		return strconv.FormatBool(v.Bool())
 	case v.IsFloat32():
@@ -59,6 +101,20 @@ func (v *Value) StringSlice(optionalDefault ...[]string) []string {
 	switch {
 	case v.IsStrSlice():
 		return v.MustStrSlice()
+	case v.IsTimeSlice():
+		slice := v.MustTimeSlice()
+		vals := make([]string, len(slice))
+		for i, iv := range slice {
+			vals[i] = iv.Format(time.RFC3339Nano)
+		}
+		return vals
+	case v.IsDurationSlice():
+		slice := v.MustDurationSlice()
+		vals := make([]string, len(slice))
+		for i, iv := range slice {
+			vals[i] = iv.String()
+		}
+		return vals
 	case v.IsBoolSlice():
 		slice := v.MustBoolSlice()
 		vals := make([]string, len(slice))
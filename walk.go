@@ -0,0 +1,128 @@
+package objx
+
+import "strconv"
+
+// walkKind is what a WalkAction tells Walk to do.
+type walkKind int
+
+const (
+	walkContinue walkKind = iota
+	walkSkip
+	walkStop
+	walkDelete
+	walkReplace
+)
+
+// WalkAction tells Walk what to do after visiting a value.
+type WalkAction struct {
+	kind  walkKind
+	value interface{}
+}
+
+var (
+	// WalkContinue makes Walk visit the children of the value, then
+	// carry on with the next value.
+	WalkContinue = WalkAction{kind: walkContinue}
+	// WalkSkip makes Walk carry on with the next value without visiting
+	// the children of the value.
+	WalkSkip = WalkAction{kind: walkSkip}
+	// WalkStop makes Walk return right away.
+	WalkStop = WalkAction{kind: walkStop}
+	// WalkDelete makes Walk remove the value from its map or array.
+	WalkDelete = WalkAction{kind: walkDelete}
+)
+
+// WalkReplace makes Walk replace the value with the specified one. The
+// new value is not walked.
+func WalkReplace(value interface{}) WalkAction {
+	return WalkAction{kind: walkReplace, value: value}
+}
+
+// WalkFunc is called by Walk for every value with its selector.
+type WalkFunc func(path string, v *Value) WalkAction
+
+// Walk visits every value of the Map depth-first, calling fn with the
+// selector of the value and the value itself. Map keys are visited in
+// sorted order and a container is visited before its children.
+//
+// The action returned by fn lets it skip the children of the value, stop
+// the walk, delete the value or replace it:
+//
+//	m.Walk(func(path string, v *objx.Value) objx.WalkAction {
+//		if v.IsNull() {
+//			return objx.WalkDelete
+//		}
+//		return objx.WalkContinue
+//	})
+//
+// Deleted array elements are removed once every element of the array has
+// been visited, so paths always refer to the array as it was when the
+// walk reached it. Values that cannot be deleted or replaced, such as
+// struct fields, are left as they are.
+func (m Map) Walk(fn WalkFunc) {
+	walk(m, "", PathSeparator, fn)
+}
+
+// Walk visits every value of the underlying Map depth-first, like
+// Map.Walk, using the separator of the View.
+func (v View) Walk(fn WalkFunc) {
+	walk(v.m, "", v.opts.sep, fn)
+}
+
+// walk visits the children of current, given the selector of current.
+//
+// It returns the replacement of current if elements of the array it holds
+// were deleted, and whether the walk was stopped.
+func walk(current interface{}, selector, sep string, fn WalkFunc) (interface{}, bool, bool) {
+	if keys, ok := keysOf(current); ok {
+		for _, key := range keys {
+			child, _ := getKey(current, key)
+			path := selector + formatKey(key, sep, selector == "")
+			action := fn(path, &Value{data: child})
+			switch action.kind {
+			case walkStop:
+				return nil, false, true
+			case walkDelete:
+				deleteKey(current, key)
+			case walkReplace:
+				setKey(current, key, action.value)
+			case walkContinue:
+				replacement, replaced, stop := walk(child, path, sep, fn)
+				if replaced {
+					setKey(current, key, replacement)
+				}
+				if stop {
+					return nil, false, true
+				}
+			}
+		}
+		return nil, false, false
+	}
+
+	var removed []int
+	stop := false
+	for i, n := 0, sliceLen(current); i < n && !stop; i++ {
+		child, _ := getIndex(current, i)
+		path := selector + "[" + strconv.Itoa(i) + "]"
+		action := fn(path, &Value{data: child})
+		switch action.kind {
+		case walkStop:
+			stop = true
+		case walkDelete:
+			removed = append(removed, i)
+		case walkReplace:
+			setIndex(current, i, action.value)
+		case walkContinue:
+			var replacement interface{}
+			var replaced bool
+			replacement, replaced, stop = walk(child, path, sep, fn)
+			if replaced {
+				setIndex(current, i, replacement)
+			}
+		}
+	}
+	if len(removed) > 0 {
+		return removeIndexes(current, removed), true, stop
+	}
+	return nil, false, stop
+}
@@ -0,0 +1,93 @@
+package objx_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/objx"
+)
+
+func TestWalk(t *testing.T) {
+	m := objx.MustFromJSON(`{"name": "Mat", "books": [{"title": "Go"}, {"title": "objx"}], "labels": {"a.b": 1}}`)
+
+	var paths []string
+	m.Walk(func(path string, v *objx.Value) objx.WalkAction {
+		paths = append(paths, path)
+		assert.Equal(t, m.Get(path).Data(), v.Data(), path)
+		return objx.WalkContinue
+	})
+
+	assert.Equal(t, []string{
+		"books",
+		"books[0]",
+		"books[0].title",
+		"books[1]",
+		"books[1].title",
+		"labels",
+		`labels["a.b"]`,
+		"name",
+	}, paths)
+}
+
+func TestWalkSkipAndStop(t *testing.T) {
+	m := objx.MustFromJSON(`{"a": {"b": 1}, "c": {"d": 2}, "e": 3}`)
+
+	var paths []string
+	m.Walk(func(path string, v *objx.Value) objx.WalkAction {
+		paths = append(paths, path)
+		switch path {
+		case "a":
+			return objx.WalkSkip
+		case "c.d":
+			return objx.WalkStop
+		}
+		return objx.WalkContinue
+	})
+
+	assert.Equal(t, []string{"a", "c", "c.d"}, paths)
+}
+
+func TestWalkDeleteAndReplace(t *testing.T) {
+	m := objx.MustFromJSON(`{
+		"name": " Mat ",
+		"nickname": null,
+		"tags": ["a", null, "b", null],
+		"books": [{"title": " Go ", "year": null}],
+		"ids": [1, 2, 3]
+	}`)
+	m["ints"] = []int{1, 2, 3}
+
+	m.Walk(func(path string, v *objx.Value) objx.WalkAction {
+		switch {
+		case v.IsNull():
+			return objx.WalkDelete
+		case v.IsStr():
+			return objx.WalkReplace(strings.TrimSpace(v.Str()))
+		case path == "ints[1]" || path == "ids[1]":
+			return objx.WalkDelete
+		case path == "ints[0]":
+			return objx.WalkReplace(0)
+		}
+		return objx.WalkContinue
+	})
+
+	assert.Equal(t, objx.Map{
+		"name":  "Mat",
+		"tags":  []interface{}{"a", "b"},
+		"books": []interface{}{map[string]interface{}{"title": "Go"}},
+		"ids":   []interface{}{float64(1), float64(3)},
+		"ints":  []int{0, 3},
+	}, m)
+}
+
+func TestWalkWithSeparator(t *testing.T) {
+	m := objx.Map{"hosts": objx.Map{"example.com": 80}}
+
+	var paths []string
+	m.WithOptions(objx.Separator("/")).Walk(func(path string, v *objx.Value) objx.WalkAction {
+		paths = append(paths, path)
+		return objx.WalkContinue
+	})
+
+	assert.Equal(t, []string{"hosts", "hosts/example.com"}, paths)
+}